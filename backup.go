@@ -0,0 +1,112 @@
+package skk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// backupName returns the name of the backup of the generation (0 is the newest)
+func backupName(filename string, generation int) string {
+	if generation <= 0 {
+		return filename + ".BAK"
+	}
+	return fmt.Sprintf("%s.BAK.%d", filename, generation)
+}
+
+// rotateBackups renames filename to the newest backup and shifts the
+// older backups keeping `n` generations.
+func rotateBackups(filename string, n int) error {
+	if n <= 0 {
+		n = 1
+	}
+	if err := os.Remove(backupName(filename, n-1)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := n - 1; i > 0; i-- {
+		if err := os.Rename(backupName(filename, i-1), backupName(filename, i)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(filename, backupName(filename, 0)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// UserJisyoBackup is a generation of the backups of the user dictionary.
+type UserJisyoBackup struct {
+	Generation int // 0 is the newest
+	Path       string
+	ModTime    time.Time
+}
+
+// backupCount returns the number of the generations of the backups kept
+func (M *Mode) backupCount() int {
+	if M.backups <= 0 {
+		return 1
+	}
+	return M.backups
+}
+
+// UserJisyoBackups returns the existing backups of the user dictionary
+// from the newest.
+func (M *Mode) UserJisyoBackups() ([]UserJisyoBackup, error) {
+	if M.userJisyoPath == "" {
+		return nil, nil
+	}
+	filename := expandEnv(M.userJisyoPath)
+	var result []UserJisyoBackup
+	for i := 0; i < M.backupCount(); i++ {
+		path := backupName(filename, i)
+		stat, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return result, err
+		}
+		result = append(result, UserJisyoBackup{
+			Generation: i,
+			Path:       path,
+			ModTime:    stat.ModTime(),
+		})
+	}
+	return result, nil
+}
+
+// ErrNoUserJisyo is the error when the user dictionary is not configured.
+var ErrNoUserJisyo = errors.New("user jisyo is not configured")
+
+// ErrBackupGeneration is the error when the generation to restore is
+// out of 0 to Config.UserJisyoBackups-1.
+var ErrBackupGeneration = errors.New("no such generation of the user jisyo backups")
+
+// RestoreUserJisyo replaces the user dictionary and its file with the
+// backup of the generation (0 is the newest). The current file becomes
+// the newest backup, so it can be restored again.
+// The words learned since the last save are discarded.
+// ErrBackupGeneration is returned for the generations not kept.
+func (M *Mode) RestoreUserJisyo(generation int) error {
+	if M.userJisyoPath == "" {
+		return ErrNoUserJisyo
+	}
+	if generation < 0 || generation >= M.backupCount() {
+		return fmt.Errorf("%d: %w", generation, ErrBackupGeneration)
+	}
+	filename := expandEnv(M.userJisyoPath)
+
+	unlock, err := lockFile(filename+".LOCK", M.lockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	j := newJisyo()
+	if _, err := j.load(backupName(filename, generation), M.userJisyoCoding); err != nil {
+		return err
+	}
+	M.User = j
+	return M.replaceUserJisyo(filename)
+}
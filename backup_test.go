@@ -0,0 +1,77 @@
+package skk
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUserJisyoBackups(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	M, err := Config{UserJisyoPath: userPath, UserJisyoBackups: 3, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	words := []string{"一", "二", "三", "四", "五"}
+	for _, w := range words {
+		M.User.Add("かず", false, NewCandidate(w))
+		if err := M.SaveUserJisyo(); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	backups, err := M.UserJisyoBackups()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(backups) != 3 {
+		t.Fatalf("expect 3 backups, but %d", len(backups))
+	}
+	if _, err := os.Stat(backupName(userPath, 3)); !os.IsNotExist(err) {
+		t.Fatal("the 4th generation should not exist")
+	}
+	// the newest backup has the words until "四"
+	for i, expect := range []string{"/四/三/二/一/", "/三/二/一/", "/二/一/"} {
+		if backups[i].Generation != i {
+			t.Fatalf("expect generation %d, but %d", i, backups[i].Generation)
+		}
+		j := newJisyo()
+		if err := j.Load(backups[i].Path); err != nil {
+			t.Fatal(err.Error())
+		}
+		list, _ := j.Lookup("かず", false)
+		if s := dumpString(list); s != expect {
+			t.Fatalf("generation %d: expect %s, but %s", i, expect, s)
+		}
+	}
+
+	for _, generation := range []int{-1, 3} {
+		if err := M.RestoreUserJisyo(generation); !errors.Is(err, ErrBackupGeneration) {
+			t.Fatalf("%d: expect ErrBackupGeneration, but %v", generation, err)
+		}
+	}
+	if err := M.RestoreUserJisyo(2); err != nil {
+		t.Fatal(err.Error())
+	}
+	list, _ := M.User.Lookup("かず", false)
+	if s := dumpString(list); s != "/二/一/" {
+		t.Fatalf("expect /二/一/, but %s", s)
+	}
+	j := newJisyo()
+	if err := j.Load(userPath); err != nil {
+		t.Fatal(err.Error())
+	}
+	list, _ = j.Lookup("かず", false)
+	if s := dumpString(list); s != "/二/一/" {
+		t.Fatalf("expect /二/一/ in the file, but %s", s)
+	}
+	// the replaced file is the newest backup
+	j = newJisyo()
+	if err := j.Load(backupName(userPath, 0)); err != nil {
+		t.Fatal(err.Error())
+	}
+	list, _ = j.Lookup("かず", false)
+	if s := dumpString(list); s != "/五/四/三/二/一/" {
+		t.Fatalf("expect /五/四/三/二/一/ in the backup, but %s", s)
+	}
+}
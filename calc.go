@@ -0,0 +1,261 @@
+package skk
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// calcPrecision is the number of the digits after the decimal point
+// of the results which are not integers
+const calcPrecision = 10
+
+const calcName = "skk-calc"
+
+var errDivisionByZero = errors.New("division by zero")
+
+var rxCalcExpression = regexp.MustCompile(`^[0-9.+\-*/%() ]+$`)
+
+// calcParser parses the arithmetic expression with the rational numbers
+// not to lose the precision of decimals.
+type calcParser struct {
+	s   string
+	pos int
+	ops int // the number of binary operators
+}
+
+func (p *calcParser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *calcParser) peek() byte {
+	p.skipSpaces()
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+// expr = term { ("+" | "-") term }
+func (p *calcParser) expr() (*big.Rat, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return x, nil
+		}
+		p.pos++
+		p.ops++
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		if op == '+' {
+			x.Add(x, y)
+		} else {
+			x.Sub(x, y)
+		}
+	}
+}
+
+// term = unary { ("*" | "/" | "%") unary }
+func (p *calcParser) term() (*big.Rat, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' && op != '%' {
+			return x, nil
+		}
+		p.pos++
+		p.ops++
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case '*':
+			x.Mul(x, y)
+		case '/':
+			if y.Sign() == 0 {
+				return nil, errDivisionByZero
+			}
+			x.Quo(x, y)
+		case '%':
+			if !x.IsInt() || !y.IsInt() {
+				return nil, errors.New("% needs integers")
+			}
+			if y.Sign() == 0 {
+				return nil, errDivisionByZero
+			}
+			x.SetInt(new(big.Int).Rem(x.Num(), y.Num()))
+		}
+	}
+}
+
+// unary = ("+" | "-") unary | primary
+func (p *calcParser) unary() (*big.Rat, error) {
+	switch p.peek() {
+	case '+':
+		p.pos++
+		return p.unary()
+	case '-':
+		p.pos++
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return x.Neg(x), nil
+	}
+	return p.primary()
+}
+
+// primary = number | "(" expr ")"
+func (p *calcParser) primary() (*big.Rat, error) {
+	if p.peek() == '(' {
+		p.pos++
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, errors.New("missing )")
+		}
+		p.pos++
+		return x, nil
+	}
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] == '.' || ('0' <= p.s[p.pos] && p.s[p.pos] <= '9')) {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, fmt.Errorf("number expected at %d", start)
+	}
+	x, ok := new(big.Rat).SetString(p.s[start:p.pos])
+	if !ok {
+		return nil, fmt.Errorf("invalid number: %s", p.s[start:p.pos])
+	}
+	return x, nil
+}
+
+func formatRat(x *big.Rat) string {
+	if x.IsInt() {
+		return x.Num().String()
+	}
+	s := strings.TrimRight(x.FloatString(calcPrecision), "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// calculate evaluates the arithmetic expression like "12*34" or
+// "(1+2)/3" with +, -, *, / and %. The results which are not integers
+// are written as decimals. isExpression is false when `expr` is not
+// an arithmetic expression with any operator, for example a number only.
+func calculate(expr string) (result string, isExpression bool, err error) {
+	if !rxCalcExpression.MatchString(expr) {
+		return "", false, nil
+	}
+	p := &calcParser{s: expr}
+	x, err := p.expr()
+	if err == nil && p.peek() != 0 {
+		err = fmt.Errorf("unexpected %c at %d", p.s[p.pos], p.pos)
+	}
+	if p.ops <= 0 && !errors.Is(err, errDivisionByZero) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", true, err
+	}
+	return formatRat(x), true, nil
+}
+
+// funSkkCalc is skk-calc of ddskk: it applies the operator to the numbers
+// of the reading (skk-num-list) like `#*# /(skk-calc '*)/`.
+func (m *lispMachine) funSkkCalc(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("skk-calc: argc error")
+	}
+	scope, ok := m.global.find("skk-num-list")
+	if !ok {
+		return nil, errors.New("skk-calc: void variable: skk-num-list")
+	}
+	list, err := listToSlice(scope.vars["skk-num-list"])
+	if err != nil {
+		return nil, err
+	}
+	numbers := make([]any, len(list))
+	for i, s := range list {
+		if numbers[i], err = funStringToNumber([]any{s}); err != nil {
+			return nil, err
+		}
+	}
+	result, err := m.call(args[0], numbers)
+	if err != nil {
+		return nil, err
+	}
+	return funNumberToString([]any{result})
+}
+
+// funSkkCalcExpression calculates the arithmetic expression given or
+// the reading (skk-henkan-key) with calculate.
+func (m *lispMachine) funSkkCalcExpression(args []any) (any, error) {
+	if len(args) > 1 {
+		return nil, errors.New("skk-calc-expression: argc error")
+	}
+	var expr any
+	if len(args) == 1 {
+		expr = args[0]
+	} else if scope, ok := m.global.find("skk-henkan-key"); ok {
+		expr = scope.vars["skk-henkan-key"]
+	}
+	s, ok := expr.(string)
+	if !ok {
+		return nil, errors.New("skk-calc-expression: not a string")
+	}
+	result, isExpression, err := calculate(s)
+	if err != nil {
+		return nil, fmt.Errorf("skk-calc-expression: %w", err)
+	}
+	if !isExpression {
+		return nil, fmt.Errorf("skk-calc-expression: not an arithmetic expression: %s", s)
+	}
+	return result, nil
+}
+
+// calcResultSource is the candidate appended for the arithmetic expression
+const calcResultSource = "(skk-calc-expression)"
+
+// isCalcResult reports whether c is the candidate appended by
+// appendCalcResult. It is neither stored in the user dictionary nor
+// studied since every expression has it.
+func isCalcResult(c candidateT) bool {
+	return c.Source() == calcResultSource
+}
+
+// appendCalcResult appends the result of the arithmetic expression of the
+// reading unless the dictionaries already gave it (with skk-calc...).
+func appendCalcResult(list []candidateT, source string, lc *lispContext, origins map[string]string) []candidateT {
+	result, _, err := calculate(source)
+	if err != nil || result == "" {
+		return list
+	}
+	for _, c := range list {
+		if word, _ := splitAnnotation(c.String()); word == result {
+			return list
+		}
+	}
+	c := parseCandidateWithContext(calcResultSource, lc)
+	if !containsCandidate(list, c) {
+		addOrigins(origins, calcName, []candidateT{c})
+		list = append(list, c)
+	}
+	return list
+}
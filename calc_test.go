@@ -0,0 +1,106 @@
+package skk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nyaosorg/go-readline-ny"
+)
+
+func TestCalculate(t *testing.T) {
+	tests := map[string]string{
+		"12*34":       "408",
+		"1+2*3":       "7",
+		"(1+2)*3":     "9",
+		"7/2":         "3.5",
+		"1/3":         "0.3333333333",
+		"0.1+0.2":     "0.3",
+		"-3+1":        "-2",
+		"7 % 3":       "1",
+		"10/4*2":      "5",
+		"2.5*4-0.5*2": "9",
+	}
+	for expr, expected := range tests {
+		result, isExpression, err := calculate(expr)
+		if err != nil || !isExpression || result != expected {
+			t.Errorf("%s: expect %s, but %s (%v, %v)", expr, expected, result, isExpression, err)
+		}
+	}
+	for _, expr := range []string{"12", "-3", "かんじ", "a+b", ""} {
+		if _, isExpression, _ := calculate(expr); isExpression {
+			t.Errorf("%s: must not be an expression", expr)
+		}
+	}
+	if _, isExpression, err := calculate("1/0"); !isExpression || !errors.Is(err, errDivisionByZero) {
+		t.Errorf("1/0: %v", err)
+	}
+	if _, isExpression, err := calculate("1/(2-2)"); !isExpression || !errors.Is(err, errDivisionByZero) {
+		t.Errorf("1/(2-2): %v", err)
+	}
+	if _, _, err := calculate("1+"); err == nil {
+		t.Error("1+: must be an error")
+	}
+}
+
+func TestLookupCalc(t *testing.T) {
+	M, err := Config{BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M.System.store("#+#", false, parseCandidates("/(skk-calc '+)/", nil))
+	M.System.store("#てん#", false, parseCandidates(`/(skk-calc (lambda (a b) (+ a (* b 0.1))))/`, nil))
+
+	tests := map[string]string{
+		"12*34":   "/408/",
+		"7/2":     "/3.5/",
+		"3+4":     "/7/", // skk-calc of the dictionary only
+		"1てん5":    "/1.5/",
+		"いち+に":    "",
+		"1/0":     "",
+		"(1+2)*3": "/9/",
+	}
+	for source, expected := range tests {
+		list, _ := M.lookup(source, false)
+		result := ""
+		if len(list) > 0 {
+			result = "/"
+			for _, c := range list {
+				result += c.String() + "/"
+			}
+		}
+		if result != expected {
+			t.Errorf("%s: expect %s, but %s", source, expected, result)
+		}
+	}
+
+	// the result is neither learned nor studied
+	list, key, _ := M.lookupWithOrigins("12*34", false, nil)
+	if key != "12*34" {
+		t.Fatalf("key: %s", key)
+	}
+	if !isCalcResult(list[0]) {
+		t.Fatalf("12*34: %s", list[0].Source())
+	}
+	M.learn("12*34", false, list[0], "")
+	if _, ok := M.User.lookup("12*34", false); ok {
+		t.Fatal("the result of 12*34 is stored in the user dictionary")
+	}
+	M.study = newStudy()
+	B := &readline.Buffer{}
+	B.InsertString(0, "工作")
+	M.studyResult(B, 0, "こうさく", false, candidateStringT("工作"))
+	B.InsertString(2, "408")
+	M.studyResult(B, 2, "12*34", false, list[0])
+	if len(M.study.changed) != 0 {
+		t.Fatal("the result of 12*34 is studied")
+	}
+	if prev := M.previousWord(B, 5); prev != "" {
+		t.Fatalf("expect no previous word after the result, but %q", prev)
+	}
+
+	// the expression written in the user dictionary is calculated again for the other numbers
+	M.User.storeAndLearn("#*#", false, learnedList(nil, list[0], ""))
+	if list, key, _ := M.lookupWithOrigins("5*6", false, nil); key != "#*#" || len(list) != 1 || list[0].String() != "30" {
+		t.Errorf("5*6: key=%s %v", key, list)
+	}
+}
@@ -0,0 +1,244 @@
+package skk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/text/encoding"
+)
+
+// cdbJisyo is a read-only dictionary of the constant database format
+// (SKK-JISYO.L.cdb) used by dbskkd-cdb and yaskkserv.
+// The key of a record is the reading and the data is "/c1/c2/.../".
+type cdbJisyo struct {
+	r        io.ReaderAt
+	closer   io.Closer
+	encoding encoding.Encoding // nil for UTF-8
+}
+
+const cdbHeaderSize = 256 * 8
+
+var errCdbFormat = errors.New("cdb: invalid format")
+
+func cdbHash(key []byte) uint32 {
+	h := uint32(5381)
+	for _, c := range key {
+		h = ((h << 5) + h) ^ uint32(c)
+	}
+	return h
+}
+
+func readUint32Pair(r io.ReaderAt, pos uint32) (uint32, uint32, error) {
+	var buffer [8]byte
+	if _, err := r.ReadAt(buffer[:], int64(pos)); err != nil {
+		return 0, 0, err
+	}
+	return binary.LittleEndian.Uint32(buffer[:4]), binary.LittleEndian.Uint32(buffer[4:]), nil
+}
+
+// isCdb reports whether the header of r is the one of the constant database.
+// The hash tables are written just after the records in order,
+// so that each table must start where the previous one ends.
+func isCdb(r io.ReaderAt, size int64) bool {
+	if size < cdbHeaderSize {
+		return false
+	}
+	var header [cdbHeaderSize]byte
+	if _, err := r.ReadAt(header[:], 0); err != nil {
+		return false
+	}
+	expect := binary.LittleEndian.Uint32(header[:4])
+	for i := 0; i < 256; i++ {
+		pos := binary.LittleEndian.Uint32(header[i*8:])
+		slots := binary.LittleEndian.Uint32(header[i*8+4:])
+		if pos < cdbHeaderSize || pos != expect || int64(pos)+int64(slots)*8 > size {
+			return false
+		}
+		expect = pos + slots*8
+	}
+	return int64(expect) == size
+}
+
+// isCdbFile reports whether filename has the extension .cdb
+// or the contents of the constant database.
+func isCdbFile(filename string) bool {
+	if strings.EqualFold(filepath.Ext(filename), ".cdb") {
+		return true
+	}
+	fd, err := os.Open(filename)
+	if err != nil {
+		return false
+	}
+	defer fd.Close()
+	stat, err := fd.Stat()
+	if err != nil {
+		return false
+	}
+	return isCdb(fd, stat.Size())
+}
+
+func openCdbJisyo(filename, coding string) (*cdbJisyo, error) {
+	fd, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return nil, err
+	}
+	c, err := newCdbJisyo(fd, stat.Size(), coding)
+	if err != nil {
+		fd.Close()
+		return nil, err
+	}
+	c.closer = fd
+	return c, nil
+}
+
+// newCdbJisyo returns the dictionary of the constant database.
+// The encoding is detected from the first records when `coding` is empty.
+func newCdbJisyo(r io.ReaderAt, size int64, coding string) (*cdbJisyo, error) {
+	if !isCdb(r, size) {
+		return nil, errCdbFormat
+	}
+	c := &cdbJisyo{r: r}
+	if enc, ok := codingSystem(coding); ok {
+		c.encoding = enc
+		return c, nil
+	}
+	var sample bytes.Buffer
+	count := 0
+	err := c.each(func(key, value []byte) error {
+		sample.Write(key)
+		sample.WriteByte(' ')
+		sample.Write(value)
+		sample.WriteByte('\n')
+		if count++; count >= 16 {
+			return io.EOF
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	c.encoding = guessEncoding(sample.Bytes())
+	return c, nil
+}
+
+// each calls f for all records in the stored order until f returns an error.
+func (c *cdbJisyo) each(f func(key, value []byte) error) error {
+	end, _, err := readUint32Pair(c.r, 0)
+	if err != nil {
+		return err
+	}
+	for pos := uint32(cdbHeaderSize); pos < end; {
+		klen, dlen, err := readUint32Pair(c.r, pos)
+		if err != nil {
+			return err
+		}
+		record := make([]byte, klen+dlen)
+		if _, err := c.r.ReadAt(record, int64(pos)+8); err != nil {
+			return err
+		}
+		if err := f(record[:klen], record[klen:]); err != nil {
+			return err
+		}
+		pos += 8 + klen + dlen
+	}
+	return nil
+}
+
+func (c *cdbJisyo) get(key []byte) ([]byte, bool, error) {
+	h := cdbHash(key)
+	tablePos, slots, err := readUint32Pair(c.r, (h%256)*8)
+	if err != nil || slots == 0 {
+		return nil, false, err
+	}
+	start := (h >> 8) % slots
+	for i := uint32(0); i < slots; i++ {
+		slotPos := tablePos + ((start+i)%slots)*8
+		slotHash, recordPos, err := readUint32Pair(c.r, slotPos)
+		if err != nil {
+			return nil, false, err
+		}
+		if recordPos == 0 {
+			return nil, false, nil
+		}
+		if slotHash != h {
+			continue
+		}
+		klen, dlen, err := readUint32Pair(c.r, recordPos)
+		if err != nil {
+			return nil, false, err
+		}
+		if klen != uint32(len(key)) {
+			continue
+		}
+		record := make([]byte, klen+dlen)
+		if _, err := c.r.ReadAt(record, int64(recordPos)+8); err != nil {
+			return nil, false, err
+		}
+		if string(record[:klen]) == string(key) {
+			return record[klen:], true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (c *cdbJisyo) decode(b []byte) (string, error) {
+	if c.encoding == nil {
+		return string(b), nil
+	}
+	return c.encoding.NewDecoder().String(string(b))
+}
+
+func (c *cdbJisyo) lookup(key string, _ bool) ([]candidateT, bool) {
+	rawKey := key
+	if c.encoding != nil {
+		var err error
+		if rawKey, err = c.encoding.NewEncoder().String(key); err != nil {
+			return nil, false
+		}
+	}
+	value, ok, err := c.get([]byte(rawKey))
+	if err != nil || !ok {
+		return nil, false
+	}
+	lists, err := c.decode(value)
+	if err != nil {
+		return nil, false
+	}
+	list := parseCandidates(strings.TrimRight(lists, "\r\n"), nil)
+	return list, len(list) > 0
+}
+
+// loadTo stores all records into the Jisyo j.
+func (c *cdbJisyo) loadTo(j *Jisyo) error {
+	return c.each(func(rawKey, value []byte) error {
+		key, err := c.decode(rawKey)
+		if err != nil {
+			return err
+		}
+		lists, err := c.decode(value)
+		if err != nil {
+			return err
+		}
+		okuri := isOkuriAriKey(key)
+		values, _ := j.lookup(key, okuri)
+		j.store(key, okuri, parseCandidates(strings.TrimRight(lists, "\r\n"), values))
+		return nil
+	})
+}
+
+func (c *cdbJisyo) Close() error {
+	if c.closer == nil {
+		return nil
+	}
+	return c.closer.Close()
+}
@@ -0,0 +1,104 @@
+package skk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// makeCdb builds the image of a constant database.
+func makeCdb(keys, values []string) []byte {
+	type slot struct{ hash, pos uint32 }
+	var records bytes.Buffer
+	var tables [256][]slot
+	pos := uint32(cdbHeaderSize)
+	for i, key := range keys {
+		binary.Write(&records, binary.LittleEndian, uint32(len(key)))
+		binary.Write(&records, binary.LittleEndian, uint32(len(values[i])))
+		records.WriteString(key)
+		records.WriteString(values[i])
+		h := cdbHash([]byte(key))
+		tables[h%256] = append(tables[h%256], slot{hash: h, pos: pos})
+		pos += uint32(8 + len(key) + len(values[i]))
+	}
+	var header, body bytes.Buffer
+	for _, table := range tables {
+		n := uint32(len(table) * 2)
+		binary.Write(&header, binary.LittleEndian, pos)
+		binary.Write(&header, binary.LittleEndian, n)
+		slots := make([]slot, n)
+		for _, s := range table {
+			i := (s.hash >> 8) % n
+			for slots[i].pos != 0 {
+				i = (i + 1) % n
+			}
+			slots[i] = s
+		}
+		for _, s := range slots {
+			binary.Write(&body, binary.LittleEndian, s.hash)
+			binary.Write(&body, binary.LittleEndian, s.pos)
+		}
+		pos += n * 8
+	}
+	return append(append(header.Bytes(), records.Bytes()...), body.Bytes()...)
+}
+
+func TestCdbJisyo(t *testing.T) {
+	enc := japanese.EUCJP.NewEncoder()
+	var keys, values []string
+	for key, value := range map[string]string{
+		"かんじ": "/漢字/幹事/",
+		"おくr": "/送/贈/",
+		"#じ":  "/#1時/",
+	} {
+		k, _ := enc.String(key)
+		v, _ := enc.String(value)
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	image := makeCdb(keys, values)
+	if !isCdb(bytes.NewReader(image), int64(len(image))) {
+		t.Fatal("isCdb: false")
+	}
+	if isCdb(bytes.NewReader(image[1:]), int64(len(image)-1)) {
+		t.Fatal("isCdb: true for a broken image")
+	}
+	// without the extension .cdb
+	fname := filepath.Join(t.TempDir(), "SKK-JISYO.test")
+	if err := os.WriteFile(fname, image, 0666); err != nil {
+		t.Fatal(err.Error())
+	}
+	M, err := Config{
+		SystemJisyoPaths: []string{fname},
+		BindTo:           dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+
+	if list, ok := M.lookup("かんじ", false); !ok || len(list) != 2 || list[1].String() != "幹事" {
+		t.Fatalf("かんじ: %v", list)
+	}
+	if list, ok := M.lookup("おくr", true); !ok || list[0].String() != "送" {
+		t.Fatalf("おくr: %v", list)
+	}
+	if list, ok := M.lookup("3じ", false); !ok || list[0].String() != "３時" {
+		t.Fatalf("3じ: %v", list)
+	}
+	if _, ok := M.lookup("ほげ", false); ok {
+		t.Fatal("ほげ: found")
+	}
+
+	j := NewJisyo()
+	if err := j.Load(fname); err != nil {
+		t.Fatal(err.Error())
+	}
+	if list, ok := j.lookup("おくr", true); !ok || list[1].String() != "贈" {
+		t.Fatalf("Jisyo.Load: おくr: %v", list)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+
+	"github.com/nyaosorg/go-readline-skk"
+)
+
+var (
+	flagAddr = flag.String("addr", "127.0.0.1:1178", "TCP address to listen")
+	flagUnix = flag.String("unix", "", "Unix domain socket path to listen instead of TCP")
+	flagUTF8 = flag.Bool("utf8", false, "speak UTF-8 instead of EUC-JP")
+)
+
+func mains(args []string) error {
+	if len(args) <= 0 {
+		return fmt.Errorf("usage: %s [options] SKK-JISYO.L [SKK-JISYO.emoji ...]", os.Args[0])
+	}
+	jisyo := skk.NewJisyo()
+	for _, fn := range args {
+		if err := jisyo.Load(fn); err != nil {
+			return err
+		}
+	}
+	var ln net.Listener
+	var err error
+	if *flagUnix != "" {
+		ln, err = net.Listen("unix", *flagUnix)
+	} else {
+		ln, err = net.Listen("tcp", *flagAddr)
+	}
+	if err != nil {
+		return err
+	}
+	sigint := make(chan os.Signal, 1)
+	signal.Notify(sigint, os.Interrupt)
+	go func() {
+		<-sigint
+		ln.Close()
+	}()
+	fmt.Fprintln(os.Stderr, "listen", ln.Addr().String())
+
+	server := &skk.JisyoServer{
+		Jisyo: jisyo,
+		UTF8:  *flagUTF8,
+	}
+	return server.Serve(ln)
+}
+
+func main() {
+	flag.Parse()
+	if err := mains(flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
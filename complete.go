@@ -0,0 +1,264 @@
+package skk
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/nyaosorg/go-readline-ny"
+	"github.com/nyaosorg/go-readline-ny/keys"
+)
+
+// completer is a dictionary which can list the okuri-nasi readings
+// starting with the prefix.
+type completer interface {
+	complete(prefix string) ([]string, error)
+}
+
+// prefixIndexed is a dictionary which can list the okuri-nasi readings
+// starting with the prefix quickly enough to be called on each key.
+type prefixIndexed interface {
+	prefixKeys(prefix string, limit int) []string
+}
+
+type indexEntry struct {
+	key   string
+	order int
+}
+
+// keyIndex returns the sorted okuri-nasi readings
+func (j *Jisyo) keyIndex() []indexEntry {
+	if j.nasiIndex == nil && len(j.nasi) > 0 {
+		index := make([]indexEntry, 0, len(j.nasi))
+		for key := range j.nasi {
+			index = append(index, indexEntry{key: key, order: j.nasiOrder[key]})
+		}
+		sort.Slice(index, func(i, k int) bool { return index[i].key < index[k].key })
+		j.nasiIndex = index
+	}
+	return j.nasiIndex
+}
+
+// prefixKeys returns the okuri-nasi readings starting with prefix in the
+// order to be saved (the recently learned first). When limit > 0,
+// it returns the first `limit` ones of them.
+func (j *Jisyo) prefixKeys(prefix string, limit int) []string {
+	index := j.keyIndex()
+	lower := sort.Search(len(index), func(i int) bool { return index[i].key >= prefix })
+	upper := lower
+	for upper < len(index) && strings.HasPrefix(index[upper].key, prefix) {
+		upper++
+	}
+	matches := index[lower:upper]
+	// the orders are unique, so that no tie-break is needed
+	var selected []indexEntry
+	if limit <= 0 || len(matches) <= limit {
+		selected = append([]indexEntry{}, matches...)
+		sort.Slice(selected, func(i, k int) bool { return selected[i].order < selected[k].order })
+	} else {
+		// keep the first `limit` ones without sorting all of the matches
+		selected = make([]indexEntry, 0, limit+1)
+		for _, e := range matches {
+			if len(selected) >= limit && e.order > selected[limit-1].order {
+				continue
+			}
+			i := sort.Search(len(selected), func(i int) bool { return e.order < selected[i].order })
+			selected = append(selected, indexEntry{})
+			copy(selected[i+1:], selected[i:])
+			selected[i] = e
+			if len(selected) > limit {
+				selected = selected[:limit]
+			}
+		}
+	}
+	result := make([]string, len(selected))
+	for i, e := range selected {
+		result[i] = e.key
+	}
+	return result
+}
+
+// prefixKeys returns the okuri-nasi readings starting with prefix
+// in the order of the file sorted. When limit > 0, it returns
+// the first `limit` ones of them.
+func (s *sortedJisyo) prefixKeys(prefix string, limit int) []string {
+	rawPrefix := []byte(prefix)
+	if s.encoding != nil {
+		var err error
+		if rawPrefix, err = s.encoding.NewEncoder().Bytes(rawPrefix); err != nil {
+			return nil
+		}
+	}
+	i := sort.Search(len(s.nasi), func(i int) bool {
+		return bytes.Compare(s.keyAt(s.nasi[i]), rawPrefix) >= 0
+	})
+	var result []string
+	var last []byte
+	for ; i < len(s.nasi) && (limit <= 0 || len(result) < limit); i++ {
+		key := s.keyAt(s.nasi[i])
+		if !bytes.HasPrefix(key, rawPrefix) {
+			break
+		}
+		if bytes.Equal(key, last) {
+			continue
+		}
+		last = key
+		if s.encoding != nil {
+			decoded, err := s.encoding.NewDecoder().Bytes(key)
+			if err != nil {
+				continue
+			}
+			key = decoded
+		}
+		result = append(result, string(key))
+	}
+	return result
+}
+
+// completions returns the okuri-nasi readings longer than prefix and
+// starting with it in the user dictionary and the system dictionaries
+// which can list them (the CDB files can not). The readings shown by
+// Config.DynamicCompletion come first in the same order, so that Tab
+// inserts the one shown first. The ones only on the remote dictionaries
+// (skkserv) follow them.
+func (M *Mode) completions(prefix string) []string {
+	result := M.dynamicCompletions(prefix, 0)
+	seen := map[string]bool{prefix: true}
+	for _, key := range result {
+		seen[key] = true
+	}
+	for _, L := range M.layers {
+		if _, ok := L.dictionary.(prefixIndexed); ok {
+			continue
+		}
+		c, ok := L.dictionary.(completer)
+		if !ok {
+			continue
+		}
+		list, err := c.complete(prefix)
+		if err != nil {
+			continue
+		}
+		for _, key := range list {
+			if !seen[key] && strings.HasPrefix(key, prefix) {
+				seen[key] = true
+				result = append(result, key)
+			}
+		}
+	}
+	return result
+}
+
+const msgNoMoreCompletion = "補完候補がありません"
+
+// cmdComplete replaces the reading after ▽ with the readings starting
+// with it like skk-comp of ddskk. Tab or '.' shows the next one,
+// Shift+Tab or ',' the previous one, and the other keys fix it.
+// Without ▽, the command bound to Tab before SKK works.
+func (M *Mode) cmdComplete(ctx context.Context, B *readline.Buffer) readline.Result {
+	markerPos := seekMarker(B)
+	if markerPos < 0 {
+		if tab := int(keys.CtrlI[0]); tab < len(M.saveMap) && M.saveMap[tab] != nil {
+			return M.saveMap[tab].Call(ctx, B)
+		}
+		return readline.CONTINUE
+	}
+	M.hideDynamicCompletion(B)
+	prefix := B.SubString(markerPos+1, B.Cursor)
+	list := M.completions(prefix)
+	shown := false
+	defer M.hideAnnotation(B, &shown)
+	noMore := func() {
+		M.message(B, msgNoMoreCompletion)
+		shown = true
+	}
+	if len(list) <= 0 {
+		noMore()
+		return readline.CONTINUE
+	}
+	current := 0
+	B.ReplaceAndRepaint(markerPos+1, list[current])
+	for {
+		input, _ := B.GetKey()
+		switch input {
+		case keys.CtrlI, ".":
+			if current+1 >= len(list) {
+				noMore()
+				continue
+			}
+			current++
+			B.ReplaceAndRepaint(markerPos+1, list[current])
+		case keys.ShiftTab, ",":
+			if current <= 0 {
+				B.ReplaceAndRepaint(markerPos+1, prefix)
+				return readline.CONTINUE
+			}
+			current--
+			B.ReplaceAndRepaint(markerPos+1, list[current])
+		default:
+			M.hideAnnotation(B, &shown)
+			return eval(ctx, B, input)
+		}
+	}
+}
+
+// dynamicCompletions returns at most `limit` (all when limit <= 0)
+// readings longer than prefix and starting with it in the dictionaries
+// with prefixIndexed.
+// The remote dictionaries (skkserv) are not consulted not to slow typing.
+func (M *Mode) dynamicCompletions(prefix string, limit int) []string {
+	if prefix == "" {
+		return nil
+	}
+	var result []string
+	seen := map[string]bool{prefix: true}
+	n := 0
+	if limit > 0 {
+		n = limit + 1 // prefix itself may be one of them
+	}
+	add := func(d prefixIndexed) {
+		for _, key := range d.prefixKeys(prefix, n) {
+			if limit > 0 && len(result) >= limit {
+				return
+			}
+			if !seen[key] {
+				seen[key] = true
+				result = append(result, key)
+			}
+		}
+	}
+	add(M.User)
+	for _, L := range M.layers {
+		if d, ok := L.dictionary.(prefixIndexed); ok {
+			add(d)
+		}
+	}
+	add(M.System)
+	return result
+}
+
+// showDynamicCompletion shows the readings completing the one after ▽
+// on the MiniBuffer like dcomp of ddskk. Tab accepts the first one.
+func (M *Mode) showDynamicCompletion(B *readline.Buffer) {
+	if M.dynamicCompletion <= 0 {
+		return
+	}
+	markerPos := seekMarker(B)
+	if markerPos < 0 {
+		M.hideAnnotation(B, &M.dynamicCompletionShown)
+		return
+	}
+	list := M.dynamicCompletions(B.SubString(markerPos+1, B.Cursor), M.dynamicCompletion)
+	if len(list) <= 0 {
+		M.hideAnnotation(B, &M.dynamicCompletionShown)
+		return
+	}
+	M.message(B, strings.Join(list, " ")+" [Tab]")
+	M.dynamicCompletionShown = true
+}
+
+// hideDynamicCompletion erases the readings shown by showDynamicCompletion
+func (M *Mode) hideDynamicCompletion(B *readline.Buffer) {
+	M.hideAnnotation(B, &M.dynamicCompletionShown)
+}
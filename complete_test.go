@@ -0,0 +1,89 @@
+package skk
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompletions(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "SKK-JISYO.test")
+	err := os.WriteFile(fname, []byte(";; -*- coding: utf-8 -*-\n"+sampleJisyo), 0666)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, lazy := range []bool{false, true} {
+		M, err := Config{
+			SystemJisyoPaths: []string{fname},
+			LazySystemJisyo:  lazy,
+			BindTo:           dummyKeyMap{},
+		}.Setup()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		M.User.Add("かんさい", false, NewCandidate("関西"))
+		M.User.Add("かん", false, NewCandidate("缶"))
+		M.User.Add("かんじょう", false, NewCandidate("感情"))
+		M.System.store("かんこう", false, []candidateT{candidateStringT("観光")})
+
+		result := strings.Join(M.completions("かん"), ",")
+		expect := "かんじょう,かんさい,かんこく,かんじ,かんこう"
+		if !lazy {
+			// the order of keys in the file
+			expect = "かんじょう,かんさい,かんじ,かんこく,かんこう"
+		}
+		if result != expect {
+			t.Fatalf("lazy=%v: expect %s, but %s", lazy, expect, result)
+		}
+		if result := M.completions("ほげ"); len(result) != 0 {
+			t.Fatalf("expect none, but %v", result)
+		}
+	}
+}
+
+func TestDynamicCompletions(t *testing.T) {
+	M, err := Config{DynamicCompletion: 3, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, key := range []string{"かんじ", "かんこく", "かんさい", "かんき", "かんぱ", "かい"} {
+		M.System.store(key, false, []candidateT{candidateStringT(key)})
+	}
+	M.User.Add("かんそう", false, NewCandidate("乾燥"))
+	M.User.Add("かんさい", false, NewCandidate("関西"))
+
+	// the learned ones first and the others in the order of the file
+	if result := strings.Join(M.dynamicCompletions("かん", 3), ","); result != "かんさい,かんそう,かんじ" {
+		t.Fatalf("expect かんさい,かんそう,かんじ, but %s", result)
+	}
+	// the index is rebuilt after a reading is added
+	M.User.Add("かんせん", false, NewCandidate("感染"))
+	if result := strings.Join(M.dynamicCompletions("かんせ", 3), ","); result != "かんせん" {
+		t.Fatalf("expect かんせん, but %s", result)
+	}
+	all := M.System.prefixKeys("か", 0)
+	for limit := 1; limit <= len(all); limit++ {
+		if result := M.System.prefixKeys("か", limit); strings.Join(result, ",") != strings.Join(all[:limit], ",") {
+			t.Fatalf("limit=%d: expect %v, but %v", limit, all[:limit], result)
+		}
+	}
+}
+
+func TestCompletionsWithSkkServ(t *testing.T) {
+	addr := fakeSkkServ(t, map[string]string{"かんさい": "/関西/"})
+	M, err := Config{SkkServAddrs: []string{addr}, DynamicCompletion: 3, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+	M.System.store("かんじ", false, []candidateT{candidateStringT("漢字")})
+
+	// Tab inserts the reading shown first, and the one on the server follows
+	if result := strings.Join(M.dynamicCompletions("かん", 3), ","); result != "かんじ" {
+		t.Fatalf("dynamic: expect かんじ, but %s", result)
+	}
+	if result := strings.Join(M.completions("かん"), ","); result != "かんじ,かんさい" {
+		t.Fatalf("expect かんじ,かんさい, but %s", result)
+	}
+}
@@ -0,0 +1,519 @@
+package skk
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// emacsTimeLayout is the layout of current-time-string of Emacs
+const emacsTimeLayout = "Mon Jan _2 15:04:05 2006"
+
+// era is a Japanese era name (元号)
+type era struct {
+	names   [2]string // the name and the initial (gengo-index of ddskk)
+	reading string
+	start   time.Time
+}
+
+var eras = []era{
+	{names: [2]string{"令和", "R"}, reading: "れいわ", start: time.Date(2019, 5, 1, 0, 0, 0, 0, time.Local)},
+	{names: [2]string{"平成", "H"}, reading: "へいせい", start: time.Date(1989, 1, 8, 0, 0, 0, 0, time.Local)},
+	{names: [2]string{"昭和", "S"}, reading: "しょうわ", start: time.Date(1926, 12, 25, 0, 0, 0, 0, time.Local)},
+	{names: [2]string{"大正", "T"}, reading: "たいしょう", start: time.Date(1912, 7, 30, 0, 0, 0, 0, time.Local)},
+	{names: [2]string{"明治", "M"}, reading: "めいじ", start: time.Date(1868, 10, 23, 0, 0, 0, 0, time.Local)},
+}
+
+var errNoEra = errors.New("no Japanese era for the date")
+
+// eraOf returns the era of the date and the year of the era
+func eraOf(t time.Time) (*era, int, error) {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	for i := range eras {
+		if !day.Before(eras[i].start) {
+			return &eras[i], t.Year() - eras[i].start.Year() + 1, nil
+		}
+	}
+	return nil, 0, errNoEra
+}
+
+// eraOfYear returns the era which started in the year or before it.
+// For the year when the era changed, the new one is returned.
+func eraOfYear(year int) (*era, int, error) {
+	for i := range eras {
+		if year >= eras[i].start.Year() {
+			return &eras[i], year - eras[i].start.Year() + 1, nil
+		}
+	}
+	return nil, 0, errNoEra
+}
+
+// eraYearString writes the year of the era with the numeric conversion
+// type. The first year is "元" unless notGannen.
+func eraYearString(year int, numberType byte, notGannen bool) string {
+	if year == 1 && !notGannen {
+		return "元"
+	}
+	return formatNumber(numberType, strconv.Itoa(year))
+}
+
+var kanjiWeekdays = [7]string{"日", "月", "火", "水", "木", "金", "土"}
+
+// monthNames and weekdayNames are skk-month-alist and
+// skk-day-of-week-alist of ddskk indexed with month-alist-index and
+// dayofweek-alist-index.
+var (
+	monthNames = map[string][2]string{
+		"Jan": {"1", "Jan."}, "Feb": {"2", "Feb."}, "Mar": {"3", "Mar."},
+		"Apr": {"4", "Apr."}, "May": {"5", "May"}, "Jun": {"6", "Jun."},
+		"Jul": {"7", "Jul."}, "Aug": {"8", "Aug."}, "Sep": {"9", "Sep."},
+		"Oct": {"10", "Oct."}, "Nov": {"11", "Nov."}, "Dec": {"12", "Dec."},
+	}
+	weekdayNames = map[string][2]string{
+		"Sun": {"日", "Sun."}, "Mon": {"月", "Mon."}, "Tue": {"火", "Tue."},
+		"Wed": {"水", "Wed."}, "Thu": {"木", "Thu."}, "Fri": {"金", "Fri."},
+		"Sat": {"土", "Sat."},
+	}
+)
+
+// formatTime is format-time-string of Emacs in the C locale.
+// `%-d` suppresses the padding. %EC (the era name), %Ey (the year of
+// the era), %EY (like "令和元年") and %Ea (the weekday in kanji) are
+// the extensions for Japanese.
+func formatTime(t time.Time, format string) (string, error) {
+	var buffer strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			buffer.WriteByte(format[i])
+			continue
+		}
+		i++
+		pad := true
+		if format[i] == '-' && i+1 < len(format) {
+			pad = false
+			i++
+		}
+		num := func(n, width int) {
+			if pad {
+				fmt.Fprintf(&buffer, "%0*d", width, n)
+			} else {
+				fmt.Fprintf(&buffer, "%d", n)
+			}
+		}
+		hour12 := t.Hour() % 12
+		if hour12 == 0 {
+			hour12 = 12
+		}
+		var sub string
+		switch d := format[i]; d {
+		case 'Y':
+			num(t.Year(), 1)
+		case 'y':
+			num(t.Year()%100, 2)
+		case 'C':
+			num(t.Year()/100, 2)
+		case 'm':
+			num(int(t.Month()), 2)
+		case 'd':
+			num(t.Day(), 2)
+		case 'e':
+			if pad {
+				fmt.Fprintf(&buffer, "%2d", t.Day())
+			} else {
+				num(t.Day(), 1)
+			}
+		case 'H':
+			num(t.Hour(), 2)
+		case 'I':
+			num(hour12, 2)
+		case 'M':
+			num(t.Minute(), 2)
+		case 'S':
+			num(t.Second(), 2)
+		case 'j':
+			num(t.YearDay(), 3)
+		case 'u':
+			num((int(t.Weekday())+6)%7+1, 1)
+		case 'w':
+			num(int(t.Weekday()), 1)
+		case 'p':
+			buffer.WriteString(t.Format("PM"))
+		case 'a':
+			buffer.WriteString(t.Format("Mon"))
+		case 'A':
+			buffer.WriteString(t.Weekday().String())
+		case 'b', 'h':
+			buffer.WriteString(t.Format("Jan"))
+		case 'B':
+			buffer.WriteString(t.Month().String())
+		case 'Z':
+			buffer.WriteString(t.Format("MST"))
+		case 'z':
+			buffer.WriteString(t.Format("-0700"))
+		case 'F':
+			sub = "%Y-%m-%d"
+		case 'T':
+			sub = "%H:%M:%S"
+		case 'R':
+			sub = "%H:%M"
+		case 'D':
+			sub = "%m/%d/%y"
+		case 'n':
+			buffer.WriteByte('\n')
+		case 't':
+			buffer.WriteByte('\t')
+		case '%':
+			buffer.WriteByte('%')
+		case 'E':
+			if i+1 >= len(format) {
+				return "", errors.New("format-time-string: %E needs a conversion")
+			}
+			i++
+			if format[i] == 'a' {
+				buffer.WriteString(kanjiWeekdays[t.Weekday()])
+				break
+			}
+			e, year, err := eraOf(t)
+			if err != nil {
+				return "", err
+			}
+			switch format[i] {
+			case 'C':
+				buffer.WriteString(e.names[0])
+			case 'y':
+				buffer.WriteString(eraYearString(year, '0', false))
+			case 'Y':
+				buffer.WriteString(e.names[0] + eraYearString(year, '0', false) + "年")
+			default:
+				return "", fmt.Errorf("format-time-string: invalid conversion %%E%c", format[i])
+			}
+		default:
+			return "", fmt.Errorf("format-time-string: invalid conversion %%%c", d)
+		}
+		if sub != "" {
+			s, err := formatTime(t, sub)
+			if err != nil {
+				return "", err
+			}
+			buffer.WriteString(s)
+		}
+	}
+	return buffer.String(), nil
+}
+
+// lispNumberType returns the numeric conversion type of skk-number-style
+// and num-type of ddskk: nil or 0 for ASCII, t or 1 for full-width...
+func lispNumberType(v any) byte {
+	switch n := v.(type) {
+	case bool:
+		return '1'
+	case int64:
+		if 0 <= n && n <= 9 {
+			return byte('0' + n)
+		}
+	}
+	return '0'
+}
+
+// globalValue returns the global variable or nil when it is void
+func (m *lispMachine) globalValue(name string) any {
+	if scope, ok := m.global.find(name); ok {
+		return scope.vars[name]
+	}
+	return nil
+}
+
+// lispTime returns the time given as the seconds from the epoch,
+// or the current time for nil.
+func (m *lispMachine) lispTime(name string, v any) (time.Time, error) {
+	if v == nil {
+		return m.now(), nil
+	}
+	if n, ok := v.(int64); ok {
+		return time.Unix(n, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("%s: invalid time: %s", name, lispPrin1(v))
+}
+
+func (m *lispMachine) funCurrentTimeString(args []any) (any, error) {
+	var v any
+	if len(args) > 0 {
+		v = args[0]
+	}
+	t, err := m.lispTime("current-time-string", v)
+	if err != nil {
+		return nil, err
+	}
+	return t.Format(emacsTimeLayout), nil
+}
+
+func (m *lispMachine) funFormatTimeString(args []any) (any, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, errors.New("format-time-string: argc error")
+	}
+	format, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("format-time-string: not a string")
+	}
+	var v any
+	if len(args) > 1 {
+		v = args[1]
+	}
+	t, err := m.lispTime("format-time-string", v)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) > 2 && args[2] != nil {
+		t = t.UTC()
+	}
+	return formatTime(t, format)
+}
+
+// dateInformation is the date-information of ddskk given to the
+// pp-function of skk-current-date: ("2026" "Oct" "18" "Sun" "12" "34" "56")
+func dateInformation(t time.Time) any {
+	return sliceToList([]any{
+		strconv.Itoa(t.Year()), t.Format("Jan"), strconv.Itoa(t.Day()),
+		t.Format("Mon"), t.Format("15"), t.Format("04"), t.Format("05"),
+	})
+}
+
+// alistIndex returns the index of ddskk's alists or -1 for nil
+func alistIndex(name string, v any, size int) (int, error) {
+	if v == nil {
+		return -1, nil
+	}
+	n, ok := v.(int64)
+	if !ok || n < 0 || n >= int64(size) {
+		return 0, fmt.Errorf("%s: invalid index: %s", name, lispPrin1(v))
+	}
+	return int(n), nil
+}
+
+// funSkkDefaultCurrentDate is skk-default-current-date of ddskk:
+// (skk-default-current-date date-information format num-type gengo
+// gengo-index month-alist-index dayofweek-alist-index &optional and-time)
+func funSkkDefaultCurrentDate(args []any) (any, error) {
+	const name = "skk-default-current-date"
+	if len(args) < 7 || len(args) > 8 {
+		return nil, fmt.Errorf("%s: argc error", name)
+	}
+	info, err := listToSlice(args[0])
+	if err != nil || len(info) < 7 {
+		return nil, fmt.Errorf("%s: invalid date-information: %s", name, lispPrin1(args[0]))
+	}
+	fields := make([]string, len(info))
+	for i, v := range info {
+		fields[i] = lispString(v)
+	}
+	andTime := len(args) > 7 && args[7] != nil
+	format := "%s年%s月%s日(%s)"
+	if andTime {
+		format += "%s時%s分%s秒"
+	}
+	if s, ok := args[1].(string); ok {
+		format = s
+	}
+	numberType := lispNumberType(args[2])
+	gengoIndex, err := alistIndex(name, args[4], 2)
+	if err != nil {
+		return nil, err
+	}
+	monthIndex, err := alistIndex(name, args[5], 2)
+	if err != nil {
+		return nil, err
+	}
+	weekdayIndex, err := alistIndex(name, args[6], 2)
+	if err != nil {
+		return nil, err
+	}
+	year := formatNumber(numberType, fields[0])
+	if args[3] != nil {
+		t, err := time.ParseInLocation("2006 Jan 2", strings.Join(fields[:3], " "), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		e, y, err := eraOf(t)
+		if err != nil {
+			return nil, err
+		}
+		if gengoIndex < 0 {
+			gengoIndex = 0
+		}
+		year = e.names[gengoIndex] + eraYearString(y, numberType, false)
+	}
+	month := fields[1]
+	if names, ok := monthNames[month]; ok {
+		if monthIndex <= 0 {
+			month = formatNumber(numberType, names[0])
+		} else {
+			month = names[monthIndex]
+		}
+	}
+	weekday := fields[3]
+	if names, ok := weekdayNames[weekday]; ok && weekdayIndex >= 0 {
+		weekday = names[weekdayIndex]
+	}
+	values := []any{format, year, month, formatNumber(numberType, fields[2]), weekday}
+	if andTime {
+		for _, s := range fields[4:7] {
+			values = append(values, formatNumber(numberType, s))
+		}
+	}
+	return funFormat(values)
+}
+
+// currentDate is skk-current-date for the time t
+func (m *lispMachine) currentDate(t time.Time, args []any) (any, error) {
+	var pp, format, andTime any
+	if len(args) > 0 {
+		pp = args[0]
+	}
+	if len(args) > 1 {
+		format = args[1]
+	}
+	if len(args) > 2 {
+		andTime = args[2]
+	}
+	gengo := lispBool(m.globalValue("skk-date-ad") == nil)
+	if pp != nil {
+		return m.call(pp, []any{dateInformation(t), format, gengo, andTime})
+	}
+	return funSkkDefaultCurrentDate([]any{dateInformation(t), format,
+		m.globalValue("skk-number-style"), gengo, int64(0), nil, int64(0), andTime})
+}
+
+// funSkkCurrentDate is skk-current-date of ddskk:
+// (skk-current-date &optional pp-function format and-time)
+// The year is written in the Japanese era unless skk-date-ad,
+// and the numbers with skk-number-style.
+func (m *lispMachine) funSkkCurrentDate(args []any) (any, error) {
+	if len(args) > 3 {
+		return nil, errors.New("skk-current-date: argc error")
+	}
+	return m.currentDate(m.now(), args)
+}
+
+// funSkkRelativeDate is skk-relative-date of ddskk:
+// (skk-relative-date pp-function format and-time &key yy mm dd)
+func (m *lispMachine) funSkkRelativeDate(args []any) (any, error) {
+	if len(args) < 3 || len(args)%2 != 1 {
+		return nil, errors.New("skk-relative-date: argc error")
+	}
+	var years, months, days int
+	for i := 3; i < len(args); i += 2 {
+		n, ok := args[i+1].(int64)
+		if !ok {
+			return nil, fmt.Errorf("skk-relative-date: not an integer: %s", lispPrin1(args[i+1]))
+		}
+		switch args[i] {
+		case ":yy":
+			years = int(n)
+		case ":mm":
+			months = int(n)
+		case ":dd":
+			days = int(n)
+		default:
+			return nil, fmt.Errorf("skk-relative-date: invalid keyword: %s", lispPrin1(args[i]))
+		}
+	}
+	return m.currentDate(m.now().AddDate(years, months, days), args[:3])
+}
+
+// funSkkToday returns skk-current-date without the format, or
+// format-time-string of the format with the numbers of skk-number-style.
+func (m *lispMachine) funSkkToday(args []any) (any, error) {
+	if len(args) > 1 {
+		return nil, errors.New("skk-today: argc error")
+	}
+	if len(args) == 0 || args[0] == nil {
+		return m.currentDate(m.now(), nil)
+	}
+	format, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("skk-today: not a string")
+	}
+	s, err := formatTime(m.now(), format)
+	if err != nil {
+		return nil, err
+	}
+	numberType := lispNumberType(m.globalValue("skk-number-style"))
+	return rxNumber.ReplaceAllStringFunc(s, func(n string) string {
+		return formatNumber(numberType, n)
+	}), nil
+}
+
+// funSkkAdToGengo is skk-ad-to-gengo of ddskk converting the year
+// of the reading (skk-num-list) like `#ねん /(skk-ad-to-gengo 0 nil "年")/`:
+// (skk-ad-to-gengo gengo-index &optional divider tail not-gannen)
+func (m *lispMachine) funSkkAdToGengo(args []any) (any, error) {
+	const name = "skk-ad-to-gengo"
+	if len(args) < 1 || len(args) > 4 {
+		return nil, fmt.Errorf("%s: argc error", name)
+	}
+	gengoIndex, err := alistIndex(name, args[0], 2)
+	if err != nil || gengoIndex < 0 {
+		return nil, fmt.Errorf("%s: invalid index: %s", name, lispPrin1(args[0]))
+	}
+	numbers, err := listToSlice(m.globalValue("skk-num-list"))
+	if err != nil || len(numbers) < 1 {
+		return nil, fmt.Errorf("%s: no number in the reading", name)
+	}
+	year, err := strconv.Atoi(lispString(numbers[0]))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	e, y, err := eraOfYear(year)
+	if err != nil {
+		return nil, err
+	}
+	var divider, tail string
+	if len(args) > 1 && args[1] != nil {
+		divider = lispString(args[1])
+	}
+	if len(args) > 2 && args[2] != nil {
+		tail = lispString(args[2])
+	}
+	notGannen := len(args) > 3 && args[3] != nil
+	return e.names[gengoIndex] + divider + eraYearString(y, '0', notGannen) + tail, nil
+}
+
+// funSkkGengoToAd is skk-gengo-to-ad of ddskk converting the year of
+// the era in the reading like `へいせい#ねん /(skk-gengo-to-ad "" "年")/`:
+// (skk-gengo-to-ad &optional head tail)
+func (m *lispMachine) funSkkGengoToAd(args []any) (any, error) {
+	const name = "skk-gengo-to-ad"
+	if len(args) > 2 {
+		return nil, fmt.Errorf("%s: argc error", name)
+	}
+	key := lispString(m.globalValue("skk-henkan-key"))
+	numbers, err := listToSlice(m.globalValue("skk-num-list"))
+	if err != nil || len(numbers) < 1 {
+		return nil, fmt.Errorf("%s: no number in the reading", name)
+	}
+	year, err := strconv.Atoi(lispString(numbers[0]))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	for i, e := range eras {
+		if strings.HasPrefix(key, e.reading) {
+			// eras are in the order of the newest, and the last year of
+			// an era is the first one of the next
+			ad := e.start.Year() + year - 1
+			if year <= 0 || (i > 0 && ad > eras[i-1].start.Year()) {
+				return nil, fmt.Errorf("%s: no year %d of %s", name, year, e.names[0])
+			}
+			var head, tail string
+			if len(args) > 0 && args[0] != nil {
+				head = lispString(args[0])
+			}
+			if len(args) > 1 && args[1] != nil {
+				tail = lispString(args[1])
+			}
+			return head + strconv.Itoa(ad) + tail, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: no era in the reading: %s", name, key)
+}
@@ -0,0 +1,89 @@
+package skk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTime(t *testing.T) {
+	now := time.Date(2019, 5, 1, 9, 5, 7, 0, time.Local)
+	tests := map[string]string{
+		"%Y-%m-%d %H:%M:%S": "2019-05-01 09:05:07",
+		"%-m/%-d %I%p":      "5/1 09AM",
+		"%F %T":             "2019-05-01 09:05:07",
+		"%a %b %e":          "Wed May  1",
+		"%EC%Ey年":           "令和元年",
+		"%EY%-m月%-d日(%Ea)":  "令和元年5月1日(水)",
+		"%%Y":               "%Y",
+	}
+	for format, expected := range tests {
+		result, err := formatTime(now, format)
+		if err != nil || result != expected {
+			t.Errorf("%s: expect %s, but %s (%v)", format, expected, result, err)
+		}
+	}
+	if result, _ := formatTime(now.AddDate(0, 0, -1), "%EY"); result != "平成31年" {
+		t.Errorf("the day before 令和: %s", result)
+	}
+	if _, err := formatTime(now, "%Q"); err == nil {
+		t.Error("%Q: must be an error")
+	}
+}
+
+func TestLookupDate(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 34, 56, 0, time.Local)
+	newMode := func(c Config) *Mode {
+		c.BindTo = dummyKeyMap{}
+		c.Now = func() time.Time { return now }
+		M, err := c.Setup()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		M.System.store("きょう", false, parseCandidates(`/(skk-current-date)/(skk-today "%Y-%m-%d")/`, nil))
+		M.System.store("きのう", false, parseCandidates(`/(skk-relative-date nil nil nil :dd -1)/`, nil))
+		M.System.store("いま", false, parseCandidates(`/(current-time-string)/(format-time-string "%EY%-m月%-d日(%Ea)")/`, nil))
+		M.System.store("#ねん", false, parseCandidates(`/(skk-ad-to-gengo 0 nil "年")/(skk-ad-to-gengo 1 "." nil t)/`, nil))
+		M.System.store("へいせい#ねん", false, parseCandidates(`/(skk-gengo-to-ad "" "年")/`, nil))
+		// the form of SKK-JISYO.L
+		M.System.store("ひづけ", false, parseCandidates(`/(skk-current-date (lambda (date-information format gengo and-time) (skk-default-current-date date-information nil 0 gengo 0 0 0)))/`, nil))
+		return M
+	}
+	lookup := func(M *Mode, source string) string {
+		list, _ := M.lookup(source, false)
+		result := "/"
+		for _, c := range list {
+			result += c.String() + "/"
+		}
+		return result
+	}
+	tests := []struct {
+		config   Config
+		source   string
+		expected string
+	}{
+		{Config{}, "きょう", "/令和8年10月18日(日)/2026-10-18/"},
+		{Config{DateAD: true}, "きょう", "/2026年10月18日(日)/2026-10-18/"},
+		{Config{NumberStyle: 1}, "きょう", "/令和８年１０月１８日(日)/２０２６-１０-１８/"},
+		{Config{NumberStyle: 3}, "きのう", "/令和八年十月十七日(土)/"},
+		{Config{}, "いま", "/Sun Oct 18 12:34:56 2026/令和8年10月18日(日)/"},
+		{Config{}, "2019ねん", "/令和元年/R.1/"},
+		{Config{}, "1989ねん", "/平成元年/H.1/"},
+		{Config{}, "ひづけ", "/令和8年10月18日(日)/"},
+		{Config{DateAD: true}, "ひづけ", "/2026年10月18日(日)/"},
+		{Config{}, "へいせい8ねん", "/1996年/"},
+		{Config{}, "へいせい31ねん", "/2019年/"},
+		{Config{}, "へいせい50ねん", `/(skk-gengo-to-ad "" "年")/`},
+		{Config{}, "へいせい0ねん", `/(skk-gengo-to-ad "" "年")/`},
+	}
+	for _, tt := range tests {
+		if result := lookup(newMode(tt.config), tt.source); result != tt.expected {
+			t.Errorf("%s (DateAD=%v NumberStyle=%d): expect %s, but %s",
+				tt.source, tt.config.DateAD, tt.config.NumberStyle, tt.expected, result)
+		}
+	}
+
+	now = time.Date(2019, 5, 1, 0, 0, 0, 0, time.Local)
+	if result := lookup(newMode(Config{}), "きょう"); result != "/令和元年5月1日(水)/2019-05-01/" {
+		t.Errorf("元年: %s", result)
+	}
+}
@@ -0,0 +1,30 @@
+package skk
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/ulikunitz/xz"
+)
+
+var (
+	gzipMagic = []byte{0x1F, 0x8B}
+	xzMagic   = []byte{0xFD, '7', 'z', 'X', 'Z', 0x00}
+)
+
+// decompress returns the reader of the uncompressed contents
+// when r starts with the magic bytes of gzip or xz.
+// Otherwise it returns the reader of r as it is.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(xzMagic))
+	if bytes.HasPrefix(magic, gzipMagic) {
+		return gzip.NewReader(br)
+	}
+	if bytes.HasPrefix(magic, xzMagic) {
+		return xz.NewReader(br)
+	}
+	return br, nil
+}
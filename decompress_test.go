@@ -0,0 +1,58 @@
+package skk
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ulikunitz/xz"
+)
+
+func writeCompressed(t *testing.T, fname string, newWriter func(io.Writer) (io.WriteCloser, error)) {
+	t.Helper()
+	fd, err := os.Create(fname)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer fd.Close()
+	w, err := newWriter(fd)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	io.WriteString(w, ";; -*- coding: utf-8 -*-\n"+sampleJisyo)
+	if err := w.Close(); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestLoadCompressed(t *testing.T) {
+	dir := t.TempDir()
+	writeCompressed(t, filepath.Join(dir, "SKK-JISYO.test.gz"), func(w io.Writer) (io.WriteCloser, error) {
+		return gzip.NewWriter(w), nil
+	})
+	writeCompressed(t, filepath.Join(dir, "SKK-JISYO.test.xz"), func(w io.Writer) (io.WriteCloser, error) {
+		return xz.NewWriter(w)
+	})
+	for _, pattern := range []string{"*.gz", "*.xz"} {
+		j := newJisyo()
+		if err := j.Load(filepath.Join(dir, pattern)); err != nil {
+			t.Fatalf("%s: %s", pattern, err.Error())
+		}
+		if list, ok := j.lookup("かんじ", false); !ok || len(list) != 3 || list[2].String() != "感じ" {
+			t.Fatalf("%s: かんじ: %v", pattern, list)
+		}
+		M, err := Config{
+			SystemJisyoPaths: []string{filepath.Join(dir, pattern)},
+			LazySystemJisyo:  true,
+			BindTo:           dummyKeyMap{},
+		}.Setup()
+		if err != nil {
+			t.Fatalf("%s: %s", pattern, err.Error())
+		}
+		if list, ok := M.lookup("おくr", true); !ok || list[1].String() != "贈" {
+			t.Fatalf("%s: おくr: %v", pattern, list)
+		}
+	}
+}
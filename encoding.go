@@ -0,0 +1,388 @@
+package skk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// headSize is the size of the head of dictionaries to detect the encoding
+const headSize = 64 * 1024
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16leBOM = []byte{0xFF, 0xFE}
+	utf16beBOM = []byte{0xFE, 0xFF}
+)
+
+// codingSystem returns the encoding named `name` like the coding
+// of the Emacs pragma ("utf-8-unix", "euc-jis-2004", "shift_jis"...).
+// It returns nil for UTF-8, and false for unknown names.
+func codingSystem(name string) (encoding.Encoding, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, eol := range []string{"-unix", "-dos", "-mac"} {
+		name = strings.TrimSuffix(name, eol)
+	}
+	switch name {
+	case "utf-8", "utf8", "utf-8-emacs", "mule-utf-8", "utf-8-with-signature":
+		return nil, true
+	case "euc-jp", "eucjp", "euc-japan", "japanese-iso-8bit":
+		return japanese.EUCJP, true
+	case "euc-jis-2004", "euc-jisx0213":
+		return eucJISX0213, true
+	case "shift_jis", "shift-jis", "sjis", "cp932", "ms932", "windows-31j",
+		"japanese-shift-jis", "japanese-cp932", "shift_jis-2004":
+		return japanese.ShiftJIS, true
+	case "iso-2022-jp", "junet":
+		return japanese.ISO2022JP, true
+	}
+	return nil, false
+}
+
+// errNotJISX0213 is the error of the characters which EUC-JP writes with
+// the codes EUC-JIS-2004 reads as others: JIS X 0212 (the prefix 0x8F)
+// and the extensions of the vendors out of JIS X 0208.
+var errNotJISX0213 = errors.New("EUC-JIS-2004 reads as another character")
+
+// inJISX0208Rows reports whether the first byte `b` of EUC-JP is in the rows
+// of JIS X 0208, where EUC-JIS-2004 has the same characters as EUC-JP.
+func inJISX0208Rows(b byte) bool {
+	return (0xA1 <= b && b <= 0xA8) || (0xB0 <= b && b <= 0xF4)
+}
+
+// eucJIS2004Decoder reads the characters of JIS X 0213 with jisX0213Table
+// and the others as EUC-JP.
+type eucJIS2004Decoder struct {
+	eucjp transform.Transformer
+}
+
+func (d eucJIS2004Decoder) Reset() {
+	d.eucjp.Reset()
+}
+
+func (d eucJIS2004Decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		b := src[nSrc]
+		size := 1
+		if b == 0x8F {
+			size = 3
+		} else if b == 0x8E || (0xA1 <= b && b <= 0xFE) {
+			size = 2
+		}
+		if nSrc+size > len(src) {
+			if !atEOF {
+				return nDst, nSrc, transform.ErrShortSrc
+			}
+			size = len(src) - nSrc
+		}
+		var code uint32
+		for _, c := range src[nSrc : nSrc+size] {
+			code = code<<8 | uint32(c)
+		}
+		if s, ok := jisX0213Table[code]; ok && size > 1 {
+			if nDst+len(s) > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += copy(dst[nDst:], s)
+		} else if b < utf8.RuneSelf || b == 0x8E || inJISX0208Rows(b) {
+			n, _, err := d.eucjp.Transform(dst[nDst:], src[nSrc:nSrc+size], true)
+			if err != nil {
+				return nDst, nSrc, err
+			}
+			nDst += n
+		} else {
+			if nDst+len(string(utf8.RuneError)) > len(dst) {
+				return nDst, nSrc, transform.ErrShortDst
+			}
+			nDst += utf8.EncodeRune(dst[nDst:], utf8.RuneError)
+		}
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// jisX0213Filter is the transformer failing on the characters which
+// EUC-JP writes with the codes EUC-JIS-2004 reads as others.
+type jisX0213Filter struct{ transform.NopResetter }
+
+func isJISX0213AsEUCJP(r rune) bool {
+	b, err := japanese.EUCJP.NewEncoder().String(string(r))
+	if err != nil {
+		return false
+	}
+	s, err := eucJISX0213.NewDecoder().String(b)
+	return err == nil && s == string(r)
+}
+
+func (jisX0213Filter) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for nSrc < len(src) {
+		if !atEOF && !utf8.FullRune(src[nSrc:]) {
+			return nDst, nSrc, transform.ErrShortSrc
+		}
+		r, size := utf8.DecodeRune(src[nSrc:])
+		if r >= utf8.RuneSelf && !isJISX0213AsEUCJP(r) {
+			return nDst, nSrc, errNotJISX0213
+		}
+		if nDst+size > len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		nDst += copy(dst[nDst:], src[nSrc:nSrc+size])
+		nSrc += size
+	}
+	return nDst, nSrc, nil
+}
+
+// eucJISX0213Encoding reads EUC-JIS-2004 with jisX0213Table for JIS X 0213
+// which EUC-JP does not have, and writes only the characters which
+// EUC-JP and EUC-JIS-2004 share since the encoder is the one of EUC-JP.
+// The other characters are unrepresentable and escaped in the user
+// dictionary.
+type eucJISX0213Encoding struct{ encoding.Encoding }
+
+func (eucJISX0213Encoding) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{
+		Transformer: eucJIS2004Decoder{eucjp: japanese.EUCJP.NewDecoder()},
+	}
+}
+
+func (eucJISX0213Encoding) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{
+		Transformer: transform.Chain(jisX0213Filter{}, japanese.EUCJP.NewEncoder()),
+	}
+}
+
+var eucJISX0213 encoding.Encoding = eucJISX0213Encoding{japanese.EUCJP}
+
+// jisyoEncoding returns the encoding of the dictionary which starts with
+// `head` and the length of the byte order mark to skip.
+// It returns nil for UTF-8.
+// When `coding` is not empty, it is used instead of the detection.
+// Otherwise the encoding is decided by the BOM, the coding of the pragma
+// on the first line, or the byte sequences valid for the encoding.
+func jisyoEncoding(head []byte, coding string) (encoding.Encoding, int) {
+	if bytes.HasPrefix(head, utf8BOM) {
+		if enc, ok := codingSystem(coding); ok {
+			return enc, len(utf8BOM)
+		}
+		return nil, len(utf8BOM)
+	}
+	if enc, ok := codingSystem(coding); ok {
+		return enc, 0
+	}
+	if bytes.HasPrefix(head, utf16leBOM) {
+		return unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), len(utf16leBOM)
+	}
+	if bytes.HasPrefix(head, utf16beBOM) {
+		return unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), len(utf16beBOM)
+	}
+	if len(head) > 0 && head[0] == ';' {
+		firstLine, _, _ := bytes.Cut(head[1:], []byte{'\n'})
+		if m := pragma(string(firstLine)); m != nil {
+			if enc, ok := codingSystem(m["coding"]); ok {
+				return enc, 0
+			}
+		}
+	}
+	return guessEncoding(head), 0
+}
+
+// guessEncoding returns the encoding where `data` is valid in the order of
+// UTF-8, EUC-JP and Shift_JIS. It returns EUC-JP for ASCII, which SKK
+// dictionaries have used traditionally.
+func guessEncoding(data []byte) encoding.Encoding {
+	if isASCII(data) {
+		return japanese.EUCJP
+	}
+	if validUTF8(data) {
+		return nil
+	}
+	if !validEUCJP(data) && validShiftJIS(data) {
+		return japanese.ShiftJIS
+	}
+	return japanese.EUCJP
+}
+
+func isASCII(data []byte) bool {
+	for _, b := range data {
+		if b >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// validUTF8 is utf8.Valid allowing the last character cut off
+func validUTF8(data []byte) bool {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				data = data[:i]
+			}
+			break
+		}
+	}
+	return utf8.Valid(data)
+}
+
+func validEUCJP(data []byte) bool {
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case b < 0x80:
+			continue
+		case b == 0x8E: // JIS X 0201 kana
+			i++
+			if i < len(data) && (data[i] < 0xA1 || data[i] > 0xDF) {
+				return false
+			}
+		case b == 0x8F: // JIS X 0212
+			for k := 0; k < 2; k++ {
+				i++
+				if i < len(data) && (data[i] < 0xA1 || data[i] > 0xFE) {
+					return false
+				}
+			}
+		case 0xA1 <= b && b <= 0xFE:
+			i++
+			if i < len(data) && (data[i] < 0xA1 || data[i] > 0xFE) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func validShiftJIS(data []byte) bool {
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case b < 0x80 || (0xA1 <= b && b <= 0xDF):
+			continue
+		case (0x81 <= b && b <= 0x9F) || (0xE0 <= b && b <= 0xFC):
+			i++
+			if i < len(data) && (data[i] < 0x40 || data[i] == 0x7F || data[i] > 0xFC) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// searchableOnImage reports whether the keys encoded with enc can be
+// searched on the image of the file: the line feed and the separator
+// " /" are single bytes and the encoding has no state.
+func searchableOnImage(enc encoding.Encoding) bool {
+	return enc == nil || enc == japanese.EUCJP || enc == eucJISX0213 || enc == japanese.ShiftJIS
+}
+
+// ErrUnrepresentable is the error when the user dictionary has
+// a reading or a Lisp candidate which the encoding to save can not represent.
+var ErrUnrepresentable = errors.New("not representable in the encoding")
+
+func representable(enc encoding.Encoding, s string) bool {
+	_, err := enc.NewEncoder().String(s)
+	return err == nil
+}
+
+// escapeUnrepresentable replaces the characters which enc can not represent
+// with `\uXXXX` or `\U00XXXXXX` understood by concat of Emacs and this package.
+func escapeUnrepresentable(enc encoding.Encoding, s string) string {
+	var buffer strings.Builder
+	for _, c := range s {
+		if representable(enc, string(c)) {
+			buffer.WriteRune(c)
+		} else if c <= 0xFFFF {
+			fmt.Fprintf(&buffer, `\u%04X`, c)
+		} else {
+			fmt.Fprintf(&buffer, `\U%08X`, c)
+		}
+	}
+	return buffer.String()
+}
+
+// escapedList returns the candidates where the words which enc can not
+// represent are written as `(concat "\uXXXX")`.
+func escapedList(enc encoding.Encoding, list []candidateT) ([]candidateT, error) {
+	result := make([]candidateT, 0, len(list))
+	for _, c := range list {
+		if b, ok := c.(*candidateBlockT); ok {
+			newList, err := escapedList(enc, b.list)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, &candidateBlockT{okuri: b.okuri, list: newList})
+			continue
+		}
+		if representable(enc, c.Source()) {
+			result = append(result, c)
+			continue
+		}
+		s, ok := c.(candidateStringT)
+		if !ok {
+			return nil, fmt.Errorf("%s: %w", c.Source(), ErrUnrepresentable)
+		}
+		word, annotation := splitAnnotation(string(s))
+		if !representable(enc, annotation) {
+			return nil, fmt.Errorf("%s: %w", s, ErrUnrepresentable)
+		}
+		source := fmt.Sprintf(`(concat "%s")`,
+			escapeUnrepresentable(enc, encodeCandidate.Replace(word)))
+		if annotation != "" {
+			source += ";" + annotation
+		}
+		result = append(result, parseCandidate(source))
+	}
+	return result, nil
+}
+
+// writeToCoding outputs the dictionary with the pragma of `coding`.
+// The candidates which the encoding can not represent are escaped,
+// and ErrUnrepresentable is returned for the readings.
+func (j *Jisyo) writeToCoding(w io.Writer, coding string) (n int64, err error) {
+	enc, ok := codingSystem(coding)
+	if !ok {
+		return 0, fmt.Errorf("%s: unknown coding", coding)
+	}
+	if enc == nil {
+		return j.writeToUtf8(w)
+	}
+	escaped := j.clone()
+	for _, okuri := range []bool{true, false} {
+		m := escaped.nasi
+		if okuri {
+			m = escaped.ari
+		}
+		for key, list := range m {
+			if !representable(enc, key) {
+				return 0, fmt.Errorf("%s: %w", key, ErrUnrepresentable)
+			}
+			if m[key], err = escapedList(enc, list); err != nil {
+				return 0, err
+			}
+		}
+	}
+	for _, line := range escaped.header {
+		if !representable(enc, line) {
+			return 0, fmt.Errorf("%s: %w", line, ErrUnrepresentable)
+		}
+	}
+	var wc writeCounter
+	ew := enc.NewEncoder().Writer(w)
+	if wc.Try(fmt.Fprintf(ew, ";; -*- mode: fundamental; coding: %s -*-\n", coding)) {
+		return wc.Result()
+	}
+	wc.Try64(escaped.writeTo(ew))
+	return wc.Result()
+}
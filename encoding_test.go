@@ -0,0 +1,244 @@
+package skk
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func encodeString(t *testing.T, enc encoding.Encoding, s string) string {
+	t.Helper()
+	if enc == nil {
+		return s
+	}
+	result, err := enc.NewEncoder().String(s)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return result
+}
+
+func TestJisyoEncoding(t *testing.T) {
+	utf16le := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)
+	tests := []struct {
+		name   string
+		enc    encoding.Encoding
+		source string
+	}{
+		{"UTF-8 without pragma", nil, sampleJisyo},
+		{"UTF-8 with BOM", nil, "\xEF\xBB\xBF" + sampleJisyo},
+		{"UTF-16 with BOM", utf16le, sampleJisyo},
+		{"EUC-JP without pragma", japanese.EUCJP, sampleJisyo},
+		{"Shift_JIS with CRLF", japanese.ShiftJIS, strings.ReplaceAll(sampleJisyo, "\n", "\r\n")},
+		{"utf-8-unix", nil, ";; -*- coding: utf-8-unix -*-\n" + sampleJisyo},
+		{"euc-jis-2004", japanese.EUCJP, ";; -*- mode: fundamental; coding: euc-jis-2004 -*-\n" + sampleJisyo},
+		{"shift_jis", japanese.ShiftJIS, ";; -*- coding: shift_jis -*-\n" + sampleJisyo},
+	}
+	expect := newJisyo()
+	if err := expect.Read(strings.NewReader(";; -*- coding: utf-8 -*-\n" + sampleJisyo)); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, tt := range tests {
+		data := encodeString(t, tt.enc, tt.source)
+		full := newJisyo()
+		if err := full.Read(strings.NewReader(data)); err != nil {
+			t.Fatalf("%s: %s", tt.name, err.Error())
+		}
+		lazy := newSortedJisyo([]byte(data), "")
+		for _, key := range []string{"わすr", "おくr", "かんじ", "すらっしゅ"} {
+			okuri := isOkuriAriKey(key)
+			e, _ := expect.lookup(key, okuri)
+			if r, _ := full.lookup(key, okuri); dumpString(r) != dumpString(e) {
+				t.Fatalf("%s: Read: %s: expect %s, but %s", tt.name, key, dumpString(e), dumpString(r))
+			}
+			if r, _ := lazy.lookup(key, okuri); dumpString(r) != dumpString(e) {
+				t.Fatalf("%s: sortedJisyo: %s: expect %s, but %s", tt.name, key, dumpString(e), dumpString(r))
+			}
+		}
+	}
+}
+
+func TestSystemJisyoCodings(t *testing.T) {
+	// the pragma is wrong and is overridden with Config
+	fname := filepath.Join(t.TempDir(), "SKK-JISYO.test")
+	data := encodeString(t, japanese.EUCJP, ";; -*- coding: utf-8 -*-\n"+sampleJisyo)
+	if err := os.WriteFile(fname, []byte(data), 0666); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, lazy := range []bool{false, true} {
+		M, err := Config{
+			SystemJisyoPaths:   []string{fname},
+			SystemJisyoCodings: map[string]string{"SKK-JISYO.test": "euc-jp"},
+			LazySystemJisyo:    lazy,
+			BindTo:             dummyKeyMap{},
+		}.Setup()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if list, _ := M.lookup("かんじ", false); dumpString(list) != "/漢字/幹事/感じ/" {
+			t.Fatalf("lazy=%v: expect /漢字/幹事/感じ/, but %s", lazy, dumpString(list))
+		}
+	}
+	_, err := Config{
+		SystemJisyoPaths:   []string{fname},
+		SystemJisyoCodings: map[string]string{fname: "unknown"},
+		BindTo:             dummyKeyMap{},
+	}.Setup()
+	if err == nil {
+		t.Fatal("Setup should fail with an unknown coding")
+	}
+}
+
+func TestEUCJIS2004(t *testing.T) {
+	// 𠂉 is plane 2 (0x8F 0xA1 0xA1), which EUC-JP reads as JIS X 0212,
+	// 鷗 is plane 1 out of JIS X 0208 (0xFE 0xE5), and か゚ is two runes.
+	fname := filepath.Join(t.TempDir(), "SKK-JISYO.JIS3_4")
+	data := encodeString(t, japanese.EUCJP, ";; -*- coding: euc-jis-2004 -*-\n"+nasiHeader+"\nかもめ /") +
+		"\xFE\xE5/\x8F\xA1\xA1/\xA4\xF7/" + encodeString(t, japanese.EUCJP, "漢字/\n")
+	if err := os.WriteFile(fname, []byte(data), 0666); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, lazy := range []bool{false, true} {
+		M, err := Config{
+			SystemJisyoPaths: []string{fname},
+			LazySystemJisyo:  lazy,
+			BindTo:           dummyKeyMap{},
+		}.Setup()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if list, _ := M.lookup("かもめ", false); dumpString(list) != "/鷗/𠂉/か\u309A/漢字/" {
+			t.Fatalf("lazy=%v: expect /鷗/𠂉/か\u309A/漢字/, but %s", lazy, dumpString(list))
+		}
+		M.Close()
+	}
+
+	// 纊 of the IBM extension is written by EUC-JP as 0xF9 0xA1,
+	// which EUC-JIS-2004 reads as 硃.
+	for _, s := range []string{"𠂉", "纊"} {
+		if representable(eucJISX0213, s) {
+			t.Fatalf("%s: must not be representable", s)
+		}
+	}
+	if !representable(eucJISX0213, "漢字①") {
+		t.Fatal("漢字①: must be representable")
+	}
+}
+
+func TestUserJisyoCoding(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	M, err := Config{UserJisyoPath: userPath, UserJisyoCoding: "euc-jis-2004", BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M.User.Add("かんじ", false, NewCandidate("漢字"))
+	M.User.Add("ゆーろ", false, NewCandidate("€/ユーロ;通貨"))
+	M.User.Add("おくr", true, NewCandidate("送"))
+	if err := M.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	data, err := os.ReadFile(userPath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	text, err := japanese.EUCJP.NewDecoder().String(string(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expect := ";; -*- mode: fundamental; coding: euc-jis-2004 -*-\n" +
+		ariHeader + "\n" +
+		"おくr /送/\n" +
+		"\n" + nasiHeader + "\n" +
+		"ゆーろ /(concat \"\\u20AC\\057ユーロ\");通貨/\n" +
+		"かんじ /漢字/\n"
+	if text != expect {
+		t.Fatalf("expect\n%s\nbut\n%s", expect, text)
+	}
+
+	j := newJisyo()
+	if err := j.Load(userPath); err != nil {
+		t.Fatal(err.Error())
+	}
+	list, _ := j.Lookup("ゆーろ", false)
+	if len(list) != 1 || list[0].String() != "€/ユーロ;通貨" {
+		t.Fatalf("expect €/ユーロ;通貨, but %v", list)
+	}
+
+	M.User.Add("€", false, NewCandidate("ユーロ"))
+	if err := M.SaveUserJisyo(); !errors.Is(err, ErrUnrepresentable) {
+		t.Fatalf("expect ErrUnrepresentable, but %v", err)
+	}
+	if after, err := os.ReadFile(userPath); err != nil || string(after) != string(data) {
+		t.Fatalf("the file is changed by the failed save: %v", err)
+	}
+
+	// saved through the temporary file with the backup as UTF-8 is
+	M.User.Remove("€", false)
+	if err := M.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if backup, err := os.ReadFile(backupName(userPath, 0)); err != nil || string(backup) != string(data) {
+		t.Fatalf("expect the backup of the last file: %v", err)
+	}
+	if _, err := os.Stat(userPath + ".TMP"); !os.IsNotExist(err) {
+		t.Fatalf("expect no temporary file, but %v", err)
+	}
+}
+
+func TestUserJisyoCodingToLoad(t *testing.T) {
+	// "ｱｲ" of Shift_JIS is also valid as EUC-JP and detected so
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	data := encodeString(t, japanese.ShiftJIS, nasiHeader+"\nai /ｱｲ/\n")
+	if err := os.WriteFile(userPath, []byte(data), 0666); err != nil {
+		t.Fatal(err.Error())
+	}
+	M, err := Config{UserJisyoPath: userPath, UserJisyoCoding: "shift_jis", BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if list, _ := M.lookup("ai", false); dumpString(list) != "/ｱｲ/" {
+		t.Fatalf("expect /ｱｲ/, but %s", dumpString(list))
+	}
+}
+
+func TestUserJisyoCodingJISX0212(t *testing.T) {
+	// 鷗 is JIS X 0212 in EUC-JP (0x8F 0xEC 0xBF), which EUC-JIS-2004
+	// reads as another character of JIS X 0213 plane 2
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	M, err := Config{UserJisyoPath: userPath, UserJisyoCoding: "euc-jis-2004", BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M.User.Add("かもめ", false, NewCandidate("鷗"))
+	if err := M.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	data, err := os.ReadFile(userPath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if strings.IndexByte(string(data), 0x8F) >= 0 {
+		t.Fatal("JIS X 0212 is written")
+	}
+	if !strings.Contains(string(data), `(concat "\u9DD7")`) {
+		t.Fatalf("not escaped: %s", data)
+	}
+	M2, err := Config{UserJisyoPath: userPath, UserJisyoCoding: "euc-jis-2004", BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if list, _ := M2.lookup("かもめ", false); len(list) != 1 || list[0].String() != "鷗" {
+		t.Fatalf("expect 鷗, but %s", dumpString(list))
+	}
+
+	M.User.Add("鷗", false, NewCandidate("かもめ"))
+	if err := M.SaveUserJisyo(); !errors.Is(err, ErrUnrepresentable) {
+		t.Fatalf("expect ErrUnrepresentable, but %v", err)
+	}
+}
@@ -5,6 +5,9 @@ go 1.20
 require (
 	github.com/mattn/go-colorable v0.1.14
 	github.com/nyaosorg/go-readline-ny v1.14.1
+	github.com/nyaosorg/go-ttyadapter v0.3.0
+	github.com/ulikunitz/xz v0.5.12
+	golang.org/x/sys v0.29.0
 	golang.org/x/text v0.21.0
 )
 
@@ -13,6 +16,4 @@ require (
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/mattn/go-runewidth v0.0.19 // indirect
 	github.com/mattn/go-tty v0.0.7 // indirect
-	github.com/nyaosorg/go-ttyadapter v0.3.0 // indirect
-	golang.org/x/sys v0.29.0 // indirect
 )
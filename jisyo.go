@@ -9,10 +9,9 @@ import (
 	"os/user"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
-
-	"golang.org/x/text/encoding/japanese"
 )
 
 const (
@@ -25,11 +24,28 @@ type _History struct {
 	val []candidateT
 }
 
-type candidateT interface {
+// Candidate is one of the words for a reading in the dictionary.
+// String returns the word to display (Lisp forms are evaluated)
+// and Source returns the form written in the dictionary file.
+type Candidate interface {
 	String() string
 	Source() string
 }
 
+type candidateT = Candidate
+
+// NewCandidate returns the candidate whose display string is `word`.
+// Source() encodes it with (concat) when it contains '/'.
+func NewCandidate(word string) Candidate {
+	return candidateStringT(word)
+}
+
+// ParseCandidate returns the candidate written as `source` in the dictionary
+// like `漢字`, `漢字;annotation` or `(concat "...")`.
+func ParseCandidate(source string) Candidate {
+	return parseCandidate(source)
+}
+
 type candidateStringT string
 
 func (c candidateStringT) String() string { return string(c) }
@@ -62,12 +78,51 @@ type Jisyo struct {
 	nasi        map[string][]candidateT
 	ariHistory  []_History
 	nasiHistory []_History
+
+	// ariOrder and nasiOrder are the positions of keys on output.
+	// Keys read from a file keep the order of lines and
+	// learned keys move to the top like ddskk.
+	ariOrder  map[string]int
+	nasiOrder map[string]int
+	last      int
+	first     int
+
+	// header is the comment lines before the entries (except the pragma line)
+	header []string
+
+	// nasiIndex is the sorted okuri-nasi readings with their orders
+	// for the completion. It is built when needed and cleared when
+	// a reading is added, removed or learned.
+	nasiIndex []indexEntry
+}
+
+// NewJisyo returns an empty dictionary.
+func NewJisyo() *Jisyo {
+	return newJisyo()
 }
 
 func newJisyo() *Jisyo {
 	return &Jisyo{
-		ari:  map[string][]candidateT{},
-		nasi: map[string][]candidateT{},
+		ari:       map[string][]candidateT{},
+		nasi:      map[string][]candidateT{},
+		ariOrder:  map[string]int{},
+		nasiOrder: map[string]int{},
+	}
+}
+
+func (j *Jisyo) order(okuri bool) map[string]int {
+	if okuri {
+		return j.ariOrder
+	}
+	return j.nasiOrder
+}
+
+// moveToTop makes the key output first in its section.
+func (j *Jisyo) moveToTop(key string, okuri bool) {
+	j.first--
+	j.order(okuri)[key] = j.first
+	if !okuri {
+		j.nasiIndex = nil
 	}
 }
 
@@ -86,10 +141,19 @@ func (j *Jisyo) store(key string, okuri bool, value []candidateT) {
 	} else {
 		j.nasi[key] = value
 	}
+	order := j.order(okuri)
+	if _, ok := order[key]; !ok {
+		j.last++
+		order[key] = j.last
+		if !okuri {
+			j.nasiIndex = nil
+		}
+	}
 }
 
 func (j *Jisyo) storeAndLearn(key string, okuri bool, value []candidateT) {
 	j.store(key, okuri, value)
+	j.moveToTop(key, okuri)
 	if okuri {
 		j.ariHistory = append(j.ariHistory, _History{key: key, val: value})
 	} else {
@@ -98,11 +162,13 @@ func (j *Jisyo) storeAndLearn(key string, okuri bool, value []candidateT) {
 }
 
 func (j *Jisyo) remove(key string, okuri bool) {
+	delete(j.order(okuri), key)
 	if okuri {
 		delete(j.ari, key)
 		j.ariHistory = append(j.ariHistory, _History{key: key, val: nil})
 	} else {
 		delete(j.nasi, key)
+		j.nasiIndex = nil
 		j.nasiHistory = append(j.nasiHistory, _History{key: key, val: nil})
 	}
 }
@@ -127,21 +193,27 @@ func expandEnv(s string) string {
 // Load reads the contents of an dictionary from a file.
 // It returns time-stamp and error.
 func (j *Jisyo) Load(filename string) error {
-	filename = expandEnv(filename)
-	matches, err := filepath.Glob(filename)
-	if err != nil {
-		_, err = j.load(filename)
-		return err
-	}
-	for _, fn := range matches {
-		if _, err = j.load(fn); err != nil {
+	for _, fn := range expandJisyoPath(filename) {
+		if _, err := j.load(fn, ""); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (j *Jisyo) load(filename string) (time.Time, error) {
+// expandJisyoPath expands `~`, `%ENV%` and wildcards in filename.
+func expandJisyoPath(filename string) []string {
+	filename = expandEnv(filename)
+	matches, err := filepath.Glob(filename)
+	if err != nil {
+		return []string{filename}
+	}
+	return matches
+}
+
+// load reads the dictionary file with the encoding named `coding`
+// (detected when it is empty) and returns the modification time.
+func (j *Jisyo) load(filename, coding string) (time.Time, error) {
 	var stamp time.Time
 	fd, err := os.Open(filename)
 	if err != nil {
@@ -152,8 +224,15 @@ func (j *Jisyo) load(filename string) (time.Time, error) {
 	stat, err := fd.Stat()
 	if err == nil {
 		stamp = stat.ModTime()
+		if isCdb(fd, stat.Size()) {
+			c, err := newCdbJisyo(fd, stat.Size(), coding)
+			if err != nil {
+				return stamp, err
+			}
+			return stamp, c.loadTo(j)
+		}
 	}
-	return stamp, j.Read(fd)
+	return stamp, j.read(fd, coding)
 }
 
 func (j *Jisyo) readOne(line string, okuri bool) bool {
@@ -173,22 +252,61 @@ func (j *Jisyo) readOne(line string, okuri bool) bool {
 		return okuri
 	}
 	values, _ := j.lookup(source, okuri)
+	j.store(source, okuri, parseCandidates(lists, values))
+	return okuri
+}
+
+// parseCandidates appends the candidates in `lists` which is
+// the part after " /" of a dictionary line to `values`.
+// The okuri blocks like `[る/送/]` are appended as *candidateBlockT.
+func parseCandidates(lists string, values []candidateT) []candidateT {
+	var block *candidateBlockT
 	for {
 		one, rest, ok := strings.Cut(lists, "/")
-		if one != "" {
-			if len(one) > 2 && one[0] == '(' && one[len(one)-1] == ')' {
-				values = append(values, evalSxString(one))
-			} else {
-				values = append(values, candidateStringT(one))
+		if block != nil {
+			if one == "]" {
+				values = append(values, block)
+				block = nil
+			} else if one != "" {
+				block.list = append(block.list, parseCandidate(one))
 			}
+		} else if len(one) > 1 && one[0] == '[' && isHiragana(one[1:]) {
+			block = &candidateBlockT{okuri: one[1:]}
+		} else if one != "" {
+			values = append(values, parseCandidate(one))
 		}
 		if !ok {
 			break
 		}
 		lists = rest
 	}
-	j.store(source, okuri, values)
-	return okuri
+	if block != nil {
+		// `]` is missing
+		values = append(values, block)
+	}
+	return values
+}
+
+func parseCandidate(one string) candidateT {
+	return parseCandidateWithContext(one, nil)
+}
+
+// parseCandidateWithContext is parseCandidate evaluating Lisp
+// with the variables and the clock of lc.
+func parseCandidateWithContext(one string, lc *lispContext) candidateT {
+	if len(one) > 2 && one[0] == '(' && one[len(one)-1] == ')' {
+		return evalSxStringWithContext(one, lc)
+	}
+	if i := strings.LastIndex(one, ");"); len(one) > 2 && one[0] == '(' && i > 0 {
+		// (lisp);annotation
+		c := evalSxStringWithContext(one[:i+1], lc)
+		annotation := one[i+1:]
+		return &candidateFuncT{
+			source: one,
+			f:      func() string { return c.String() + annotation },
+		}
+	}
+	return candidateStringT(one)
 }
 
 func pragma(line string) map[string]string {
@@ -211,44 +329,52 @@ func pragma(line string) map[string]string {
 	return m
 }
 
-func peekLine(r io.Reader) (io.Reader, string, error) {
-	var buffer bytes.Buffer
-	br := bufio.NewReader(r)
-
-	line, err := br.ReadString('\n')
-	buffer.WriteString(line)
-	io.CopyN(&buffer, br, int64(br.Buffered()))
-	if err == io.EOF {
-		return &buffer, line, nil
-	}
-	return io.MultiReader(&buffer, r), line, err
+// Read reads the dictionary from r.
+// The contents compressed with gzip or xz are decompressed,
+// and the encoding is detected with jisyoEncoding.
+func (j *Jisyo) Read(r io.Reader) error {
+	return j.read(r, "")
 }
 
-func (j *Jisyo) Read(r io.Reader) error {
-	utf8mode := false
-	r, line, err := peekLine(r)
+// read reads the dictionary from r with the encoding named `coding`.
+// The encoding is detected when `coding` is empty.
+func (j *Jisyo) read(r io.Reader, coding string) error {
+	r, err := decompress(r)
 	if err != nil {
 		return err
 	}
-	if len(line) > 0 && line[0] == ';' {
-		if m := pragma(line[1:]); m != nil && m["coding"] == "utf-8" {
-			utf8mode = true
-		}
+	br := bufio.NewReaderSize(r, headSize)
+	head, err := br.Peek(headSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return err
 	}
-	if !utf8mode {
-		r = japanese.EUCJP.NewDecoder().Reader(r)
+	enc, bom := jisyoEncoding(head, coding)
+	br.Discard(bom)
+	r = br
+	if enc != nil {
+		r = enc.NewDecoder().Reader(r)
 	}
 	sc := bufio.NewScanner(r)
 	okuri := false
+	inHeader := len(j.ari) <= 0 && len(j.nasi) <= 0 && len(j.header) <= 0
 	for sc.Scan() {
-		okuri = j.readOne(sc.Text(), okuri)
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		if inHeader {
+			if strings.HasPrefix(line, ariHeader) || strings.HasPrefix(line, nasiHeader) || !strings.HasPrefix(line, ";") {
+				inHeader = false
+			} else if !strings.Contains(line, "-*-") {
+				j.header = append(j.header, line)
+			}
+		}
+		okuri = j.readOne(line, okuri)
 	}
 	return sc.Err()
 }
 
-func dumpPair(key string, list []candidateT, w io.Writer) (n int64, err error) {
+// dumpCandidates writes the candidates as "/c1/c2/.../"
+func dumpCandidates(list []candidateT, w io.Writer) (n int64, err error) {
 	var wc writeCounter
-	if wc.Try(io.WriteString(w, key)) || wc.Try(io.WriteString(w, " /")) {
+	if wc.Try(io.WriteString(w, "/")) {
 		return wc.Result()
 	}
 	for _, candidate := range list {
@@ -259,42 +385,85 @@ func dumpPair(key string, list []candidateT, w io.Writer) (n int64, err error) {
 			return wc.Result()
 		}
 	}
+	return wc.Result()
+}
+
+func dumpPair(key string, list []candidateT, w io.Writer) (n int64, err error) {
+	var wc writeCounter
+	if wc.Try(io.WriteString(w, key)) || wc.Try(io.WriteString(w, " ")) {
+		return wc.Result()
+	}
+	if wc.Try64(dumpCandidates(list, w)) {
+		return wc.Result()
+	}
 	wc.Try(io.WriteString(w, "\n"))
 	return wc.Result()
 }
 
-// WriteTo outputs the contents of dictonary with UTF8
+// sortedKeys returns the keys in the order to output:
+// the learned keys are the most recently used first,
+// and the others follow in the order they were read.
+func (j *Jisyo) sortedKeys(okuri bool) []string {
+	m := j.nasi
+	if okuri {
+		m = j.ari
+	}
+	order := j.order(okuri)
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, k int) bool {
+		if oi, ok := order[keys[i]], order[keys[k]]; oi != ok {
+			return oi < ok
+		}
+		return keys[i] < keys[k]
+	})
+	return keys
+}
+
+// writeTo outputs the contents of dictonary without the pragma line
 func (j *Jisyo) writeTo(w io.Writer) (n int64, err error) {
 	var wc writeCounter
+	for _, line := range j.header {
+		if wc.Try(fmt.Fprintln(w, line)) {
+			return wc.Result()
+		}
+	}
 	if wc.Try(fmt.Fprintln(w, ariHeader)) {
 		return wc.Result()
 	}
-	for key, list := range j.ari {
-		if wc.Try64(dumpPair(key, list, w)) {
+	for _, key := range j.sortedKeys(true) {
+		if wc.Try64(dumpPair(key, j.ari[key], w)) {
 			return wc.Result()
 		}
 	}
 	if wc.Try(fmt.Fprintf(w, "\n%s\n", nasiHeader)) {
 		return wc.Result()
 	}
-	for key, list := range j.nasi {
-		if wc.Try64(dumpPair(key, list, w)) {
+	for _, key := range j.sortedKeys(false) {
+		if wc.Try64(dumpPair(key, j.nasi[key], w)) {
 			return wc.Result()
 		}
 	}
 	return wc.Result()
 }
 
-func (j *Jisyo) saveAs(fname string) error {
-	fd, err := os.Create(fname)
-	if err != nil {
-		return err
+// saveAs writes the dictionary to the file with the encoding named
+// `coding` (UTF-8 when empty). The file is not created when the
+// dictionary can not be represented in the encoding.
+func (j *Jisyo) saveAs(fname, coding string) error {
+	var buffer bytes.Buffer
+	var err error
+	if coding == "" {
+		_, err = j.writeToUtf8(&buffer)
+	} else {
+		_, err = j.writeToCoding(&buffer, coding)
 	}
-	if _, err := j.writeToUtf8(fd); err != nil {
-		fd.Close()
+	if err != nil {
 		return err
 	}
-	return fd.Close()
+	return os.WriteFile(fname, buffer.Bytes(), 0666)
 }
 
 func (j *Jisyo) writeToUtf8(w io.Writer) (n int64, err error) {
@@ -305,3 +474,66 @@ func (j *Jisyo) writeToUtf8(w io.Writer) (n int64, err error) {
 	wc.Try64(j.writeTo(w))
 	return wc.Result()
 }
+
+// Lookup returns the candidates for the reading `key`.
+// `okuri` is true for the readings with okurigana like "おくr".
+// The candidates only in the okuri blocks (`[る/送/]`) are appended last.
+// The returned slice can be modified by the caller.
+func (j *Jisyo) Lookup(key string, okuri bool) ([]Candidate, bool) {
+	list, ok := j.lookup(key, okuri)
+	if !ok {
+		return nil, false
+	}
+	return append([]Candidate{}, selectOkuri(list, "")...), true
+}
+
+// Keys returns the readings of the okuri-ari (okuri=true) or
+// okuri-nasi (okuri=false) entries in the order to be saved.
+func (j *Jisyo) Keys(okuri bool) []string {
+	return j.sortedKeys(okuri)
+}
+
+// Store replaces the candidates for the reading `key`.
+// The change is merged into the file by Mode.SaveUserJisyo
+// when it is the user dictionary.
+func (j *Jisyo) Store(key string, okuri bool, list []Candidate) {
+	j.storeAndLearn(key, okuri, append([]candidateT{}, list...))
+}
+
+// Add inserts the candidate at the top of the candidates for `key`.
+// When it already exists, it moves to the top.
+func (j *Jisyo) Add(key string, okuri bool, c Candidate) {
+	list, _ := j.lookup(key, okuri)
+	j.storeAndLearn(key, okuri, learnedList(list, c, ""))
+}
+
+// Remove deletes the entry for the reading `key`.
+func (j *Jisyo) Remove(key string, okuri bool) {
+	j.remove(key, okuri)
+}
+
+// RemoveCandidate deletes the candidate whose Source() equals c.Source()
+// from the entry for `key`. It returns false when not found.
+func (j *Jisyo) RemoveCandidate(key string, okuri bool, c Candidate) bool {
+	list, ok := j.lookup(key, okuri)
+	if !ok || !containsCandidate(selectOkuri(list, ""), c) {
+		return false
+	}
+	if newList := purgedList(list, c); len(newList) <= 0 {
+		j.remove(key, okuri)
+	} else {
+		j.storeAndLearn(key, okuri, newList)
+	}
+	return true
+}
+
+// WriteTo outputs the contents of the dictionary with UTF-8
+// in the same format as the user dictionary saved.
+func (j *Jisyo) WriteTo(w io.Writer) (int64, error) {
+	return j.writeToUtf8(w)
+}
+
+// SaveAs writes the contents of the dictionary to the file with UTF-8.
+func (j *Jisyo) SaveAs(filename string) error {
+	return j.saveAs(filename, "")
+}
@@ -1,33 +1,130 @@
 package skk
 
 import (
-	"io"
 	"strings"
 	"testing"
 )
 
-func TestPeekLine(t *testing.T) {
-	sample := "hogehoge\n" +
-		"ahaha\n" +
-		"ihihi\n" +
-		"ohoho\n" +
-		"fehehe\n" +
-		"ufufu"
+func TestWriteToOrder(t *testing.T) {
+	source := ";; -*- mode: fundamental; coding: utf-8 -*-\n" +
+		";; my user dictionary\n" +
+		";; vim: set ft=skk:\n" +
+		ariHeader + "\n" +
+		"わすr /忘/\n" +
+		"おくr /送/\n" +
+		nasiHeader + "\n" +
+		"かんじ /漢字/\n" +
+		"あい /愛/\n" +
+		"うえ /上/\n"
+	j := newJisyo()
+	if err := j.Read(strings.NewReader(source)); err != nil {
+		t.Fatal(err.Error())
+	}
+	j.storeAndLearn("あるk", true, []candidateT{candidateStringT("歩")})
+	j.storeAndLearn("うえ", false, []candidateT{candidateStringT("上"), candidateStringT("植")})
+	j.storeAndLearn("した", false, []candidateT{candidateStringT("下")})
+	j.remove("あい", false)
 
-	var r io.Reader = strings.NewReader(sample)
-	r, line, err := peekLine(r)
+	expect := ";; my user dictionary\n" +
+		";; vim: set ft=skk:\n" +
+		ariHeader + "\n" +
+		"あるk /歩/\n" +
+		"わすr /忘/\n" +
+		"おくr /送/\n" +
+		"\n" + nasiHeader + "\n" +
+		"した /下/\n" +
+		"うえ /上/植/\n" +
+		"かんじ /漢字/\n"
+	for i := 0; i < 3; i++ {
+		var buffer strings.Builder
+		if _, err := j.writeTo(&buffer); err != nil {
+			t.Fatal(err.Error())
+		}
+		if result := buffer.String(); result != expect {
+			t.Fatalf("expect\n%s\nbut\n%s", expect, result)
+		}
+	}
+}
 
+func TestOkuriBlock(t *testing.T) {
+	j := newJisyo()
+	err := j.Read(strings.NewReader(";; -*- coding: utf-8 -*-\n" +
+		ariHeader + "\n" +
+		"おくr /贈/送/[る/送/]/[れ/送/贈/]/\n" +
+		nasiHeader + "\n" +
+		"かっこ /[/「/\n"))
 	if err != nil {
-		t.Fatalf("ERR=%s", err.Error())
+		t.Fatal(err.Error())
 	}
-	if line != "hogehoge\n" {
-		t.Fatalf("expect %s, but %s", "hogehoge\n", line)
+	entry, _ := j.lookup("おくr", true)
+	join := func(list []candidateT) string {
+		var s []string
+		for _, c := range list {
+			s = append(s, c.String())
+		}
+		return strings.Join(s, ",")
 	}
-	all, err := io.ReadAll(r)
-	if err != nil {
-		t.Fatalf("io.ReadAll: %s", err.Error())
+	if result := join(selectOkuri(entry, "る")); result != "送,贈" {
+		t.Fatalf("selectOkuri(る): %s", result)
+	}
+	if result := join(selectOkuri(entry, "り")); result != "贈,送" {
+		t.Fatalf("selectOkuri(り): %s", result)
+	}
+	if list, _ := j.lookup("かっこ", false); join(list) != "[,「" {
+		t.Fatalf("かっこ: %s", join(list))
+	}
+
+	j.storeAndLearn("おくr", true, learnedList(entry, candidateStringT("贈"), "る"))
+	j.storeAndLearn("かんがえr", true, learnedList(nil, candidateStringT("考"), "る"))
+	var buffer strings.Builder
+	j.writeTo(&buffer)
+	expect := ariHeader + "\n" +
+		"かんがえr /考/[る/考/]/\n" +
+		"おくr /贈/送/[る/贈/送/]/[れ/送/贈/]/\n" +
+		"\n" + nasiHeader + "\n" +
+		"かっこ /[/「/\n"
+	if result := buffer.String(); result != expect {
+		t.Fatalf("expect\n%s\nbut\n%s", expect, result)
+	}
+
+	entry, _ = j.lookup("おくr", true)
+	if result := join(purgedList(entry, candidateStringT("送"))); result != "贈,[る/贈/],[れ/贈/]" {
+		t.Fatalf("purgedList: %s", result)
+	}
+}
+
+func TestJisyoAPI(t *testing.T) {
+	j := NewJisyo()
+	j.Store("かんじ", false, []Candidate{NewCandidate("漢字"), ParseCandidate(`(concat "a\057b")`)})
+	j.Add("かんじ", false, NewCandidate("幹事;annotation"))
+	j.Add("おくr", true, NewCandidate("送"))
+
+	list, ok := j.Lookup("かんじ", false)
+	if !ok || len(list) != 3 {
+		t.Fatalf("Lookup: %v", list)
+	}
+	if list[0].Source() != "幹事;annotation" || list[2].String() != "a/b" || list[2].Source() != `(concat "a\057b")` {
+		t.Fatalf("Lookup: %v", list)
+	}
+	if keys := j.Keys(false); len(keys) != 1 || keys[0] != "かんじ" {
+		t.Fatalf("Keys: %v", keys)
+	}
+	if !j.RemoveCandidate("かんじ", false, NewCandidate("漢字")) {
+		t.Fatal("RemoveCandidate: false")
+	}
+	if j.RemoveCandidate("かんじ", false, NewCandidate("漢字")) {
+		t.Fatal("RemoveCandidate: true for the removed one")
+	}
+	j.Remove("おくr", true)
+	var buffer strings.Builder
+	if _, err := j.WriteTo(&buffer); err != nil {
+		t.Fatal(err.Error())
 	}
-	if string(all) != sample {
-		t.Fatalf("io.ReadAll: expect `%s` but `%s`", sample, string(all))
+	expect := ";; -*- mode: fundamental; coding: utf-8 -*-\n" +
+		ariHeader + "\n" +
+		"\n" + nasiHeader + "\n" +
+		`かんじ /幹事;annotation/(concat "a\057b")/` + "\n"
+	if result := buffer.String(); result != expect {
+		t.Fatalf("expect\n%s\nbut\n%s", expect, result)
 	}
 }
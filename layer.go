@@ -0,0 +1,147 @@
+package skk
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// jisyoLayer is one of the system dictionaries consulted after the user dictionary.
+type jisyoLayer struct {
+	name string
+	dictionary
+
+	// path, stamp, lazy and coding are used to reload the file (see reload.go)
+	path   string
+	stamp  time.Time
+	lazy   bool
+	coding string
+}
+
+// openSystemJisyo opens the dictionary file as a layer:
+// CDB files are looked up directly, the others are loaded
+// onto the memory or indexed when lazy is true.
+// `coding` is the name of the encoding, which is detected when it is empty.
+func openSystemJisyo(filename string, lazy bool, coding string) (*jisyoLayer, error) {
+	stamp, err := modTime(filename)
+	if err != nil {
+		return nil, err
+	}
+	var d dictionary
+	if isCdbFile(filename) {
+		c, err := openCdbJisyo(filename, coding)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		d = c
+	} else if lazy {
+		s, err := loadSortedJisyo(filename, coding)
+		if err != nil {
+			return nil, err
+		}
+		d = s
+	} else {
+		j := newJisyo()
+		if _, err := j.load(filename, coding); err != nil {
+			return nil, err
+		}
+		d = j
+	}
+	return &jisyoLayer{
+		name:       filepath.Base(filename),
+		dictionary: d,
+		path:       filename,
+		stamp:      stamp,
+		lazy:       lazy,
+		coding:     coding,
+	}, nil
+}
+
+// sortLayers moves the layers whose names are in `priority` to the head
+// in that order. The other layers follow in the current order.
+func sortLayers(layers []*jisyoLayer, priority []string) []*jisyoLayer {
+	result := make([]*jisyoLayer, 0, len(layers))
+	used := make([]bool, len(layers))
+	for _, name := range priority {
+		for i, L := range layers {
+			if !used[i] && L.name == name {
+				result = append(result, L)
+				used[i] = true
+			}
+		}
+	}
+	for i, L := range layers {
+		if !used[i] {
+			result = append(result, L)
+		}
+	}
+	return result
+}
+
+// SystemJisyoNames returns the names of the system dictionaries
+// (the base names of the files and "skkserv:host:port") in the priority order.
+func (M *Mode) SystemJisyoNames() []string {
+	names := make([]string, 0, len(M.layers))
+	for _, L := range M.layers {
+		names = append(names, L.name)
+	}
+	return names
+}
+
+// SystemJisyo returns the system dictionary named `name` which is loaded
+// onto the memory. The dictionaries indexed with Config.LazySystemJisyo,
+// CDB files and skkserv are not returned since they are read-only.
+func (M *Mode) SystemJisyo(name string) (*Jisyo, bool) {
+	for _, L := range M.layers {
+		if L.name == name {
+			j, ok := L.dictionary.(*Jisyo)
+			return j, ok
+		}
+	}
+	return nil, false
+}
+
+// SetSystemJisyoPriority changes the priority order of the system dictionaries.
+// The dictionaries named in `names` are consulted first in that order,
+// and the others follow in the current order.
+func (M *Mode) SetSystemJisyoPriority(names ...string) {
+	M.layers = sortLayers(M.layers, names)
+}
+
+// RemoveSystemJisyo stops consulting the system dictionary named `name`
+// and closes it. It returns false when no dictionary has the name.
+func (M *Mode) RemoveSystemJisyo(name string) (bool, error) {
+	for i, L := range M.layers {
+		if L.name != name {
+			continue
+		}
+		M.layers = append(M.layers[:i:i], M.layers[i+1:]...)
+		return true, closeLayer(L)
+	}
+	return false, nil
+}
+
+// userJisyoName is the name of the user dictionary shown as the origin of candidates
+func (M *Mode) userJisyoName() string {
+	if M.userJisyoPath == "" {
+		return "user"
+	}
+	return filepath.Base(M.userJisyoPath)
+}
+
+const systemName = "System"
+
+// addOrigins records `name` as the origin of the candidates in `list`
+// which have not been recorded yet.
+func addOrigins(origins map[string]string, name string, list []candidateT) {
+	if origins == nil {
+		return
+	}
+	for _, c := range list {
+		if b, ok := c.(*candidateBlockT); ok {
+			addOrigins(origins, name, b.list)
+		} else if _, ok := origins[c.Source()]; !ok {
+			origins[c.Source()] = name
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package skk
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSystemJisyoLayers(t *testing.T) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{
+		"SKK-JISYO.A": "かんじ /漢字/感じ/\n",
+		"SKK-JISYO.B": "かんじ /幹事/漢字/\n",
+		"SKK-JISYO.C": "かんじ /完治/\n",
+	} {
+		err := os.WriteFile(filepath.Join(dir, name),
+			[]byte(";; -*- coding: utf-8 -*-\n"+nasiHeader+"\n"+body), 0666)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	M, err := Config{
+		SystemJisyoPaths:    []string{filepath.Join(dir, "SKK-JISYO.*")},
+		SystemJisyoPriority: []string{"SKK-JISYO.B"},
+		BindTo:              dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+	M.User.store("かんじ", false, []candidateT{candidateStringT("感じ")})
+
+	if names := M.SystemJisyoNames(); !reflect.DeepEqual(names, []string{"SKK-JISYO.B", "SKK-JISYO.A", "SKK-JISYO.C"}) {
+		t.Fatalf("SystemJisyoNames: %v", names)
+	}
+	test := func(expect map[string]string) {
+		t.Helper()
+		origins := map[string]string{}
+		M.lookupWithOrigins("かんじ", false, origins)
+		if !reflect.DeepEqual(origins, expect) {
+			t.Fatalf("expect %v, but %v", expect, origins)
+		}
+	}
+	test(map[string]string{"感じ": "user", "幹事": "SKK-JISYO.B", "漢字": "SKK-JISYO.B", "完治": "SKK-JISYO.C"})
+
+	M.SetSystemJisyoPriority("SKK-JISYO.A")
+	test(map[string]string{"感じ": "user", "幹事": "SKK-JISYO.B", "漢字": "SKK-JISYO.A", "完治": "SKK-JISYO.C"})
+
+	if j, ok := M.SystemJisyo("SKK-JISYO.A"); !ok {
+		t.Fatal("SystemJisyo: SKK-JISYO.A not found")
+	} else if list, _ := j.Lookup("かんじ", false); len(list) != 2 || list[1].String() != "感じ" {
+		t.Fatalf("SystemJisyo: %v", list)
+	}
+	if _, ok := M.SystemJisyo("SKK-JISYO.X"); ok {
+		t.Fatal("SystemJisyo: SKK-JISYO.X found")
+	}
+
+	if ok, err := M.RemoveSystemJisyo("SKK-JISYO.C"); !ok || err != nil {
+		t.Fatalf("RemoveSystemJisyo: %v %v", ok, err)
+	}
+	test(map[string]string{"感じ": "user", "幹事": "SKK-JISYO.B", "漢字": "SKK-JISYO.A"})
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/nyaosorg/go-readline-skk/internal/sxencode-go/parser"
 )
@@ -73,6 +74,24 @@ func tryParseAsNumber(token string) (any, bool, error) {
 	return nil, false, nil
 }
 
+var charEscapes = map[string]rune{
+	`\n`: '\n', `\t`: '\t', `\s`: ' ', `\e`: '\x1B', `\\`: '\\',
+}
+
+// parseSymbol reads the symbol. The character literals of Emacs like
+// ?a and ?\n are read as the characters since the tokenizer does not know them.
+func parseSymbol(s string) any {
+	if len(s) > 1 && s[0] == '?' {
+		if r, ok := charEscapes[s[1:]]; ok {
+			return r
+		}
+		if r, size := utf8.DecodeRuneInString(s[1:]); size == len(s)-1 {
+			return r
+		}
+	}
+	return symbol{value: s}
+}
+
 var parser1 = &parser.Parser[any]{
 	Cons:   func(car, cdr any) any { return &cons{car: car, cdr: cdr} },
 	Number: tryParseAsNumber,
@@ -84,42 +103,432 @@ var parser1 = &parser.Parser[any]{
 	},
 	Keyword: func(s string) any { return s },
 	Rune:    func(r rune) any { return r },
-	Symbol:  func(s string) any { return symbol{value: s} },
+	Symbol:  parseSymbol,
 	Null:    func() any { return nil },
 	True:    func() any { return true },
 }
 
-var rxEscSeq = regexp.MustCompile(`\\[0-9]+`)
+var rxEscSeq = regexp.MustCompile(`\\([0-9]+|u[0-9A-Fa-f]{4}|U[0-9A-Fa-f]{8})`)
+
+// lispMaxDepth limits the nesting of the evaluation not to overflow
+// the stack with the recursion of closures in dictionaries.
+const lispMaxDepth = 1000
+
+var errLispTooDeep = errors.New("too deep evaluation")
+
+// lispEnv is the scope of variables bound by let and lambda
+type lispEnv struct {
+	vars   map[string]any
+	parent *lispEnv
+}
+
+func newLispEnv(parent *lispEnv) *lispEnv {
+	return &lispEnv{vars: map[string]any{}, parent: parent}
+}
+
+// find returns the scope where `name` is bound
+func (e *lispEnv) find(name string) (*lispEnv, bool) {
+	for ; e != nil; e = e.parent {
+		if _, ok := e.vars[name]; ok {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// lispMachine evaluates S-expressions with the special forms and
+// the functions of `funcs` which receive the evaluated arguments.
+type lispMachine struct {
+	funcs     map[string]func([]any) (any, error)
+	global    *lispEnv
+	depth     int
+	matchData []int            // the positions in runes of the last string-match
+	now       func() time.Time // the clock of the date functions
+}
 
-func evalSxList(funcs map[string]func([]any) (any, error), sxpr any) (any, error) {
-	list := []any{}
-	for {
+func newLispMachine(funcs map[string]func([]any) (any, error)) *lispMachine {
+	m := &lispMachine{
+		funcs:  make(map[string]func([]any) (any, error), len(funcs)+14),
+		global: newLispEnv(nil),
+		now:    time.Now,
+	}
+	for name, f := range funcs {
+		m.funcs[name] = f
+	}
+	// the functions using the match data, the variables, the clock
+	// or calling functions
+	m.funcs["string-match"] = m.funStringMatch
+	m.funcs["match-string"] = m.funMatchString
+	m.funcs["match-beginning"] = m.funMatchBeginning
+	m.funcs["match-end"] = m.funMatchEnd
+	m.funcs["replace-regexp-in-string"] = m.funReplaceRegexpInString
+	m.funcs["skk-calc"] = m.funSkkCalc
+	m.funcs["skk-calc-expression"] = m.funSkkCalcExpression
+	m.funcs["current-time-string"] = m.funCurrentTimeString
+	m.funcs["format-time-string"] = m.funFormatTimeString
+	m.funcs["skk-current-date"] = m.funSkkCurrentDate
+	m.funcs["skk-default-current-date"] = funSkkDefaultCurrentDate
+	m.funcs["skk-relative-date"] = m.funSkkRelativeDate
+	m.funcs["skk-today"] = m.funSkkToday
+	m.funcs["skk-ad-to-gengo"] = m.funSkkAdToGengo
+	m.funcs["skk-gengo-to-ad"] = m.funSkkGengoToAd
+	return m
+}
+
+// lispClosure is the function made by lambda. It keeps the scope
+// where it was made (lexical binding).
+type lispClosure struct {
+	params any
+	body   []any
+	env    *lispEnv
+	m      *lispMachine
+}
+
+func (c *lispClosure) call(args []any) (any, error) {
+	params, err := listToSlice(c.params)
+	if err != nil {
+		return nil, err
+	}
+	env := newLispEnv(c.env)
+	mode := ""
+	i := 0
+	for _, p := range params {
+		if s, ok := p.(string); ok && (s == "&optional" || s == "&rest") {
+			mode = s
+			continue
+		}
+		sym, ok := p.(symbol)
+		if !ok {
+			return nil, fmt.Errorf("invalid parameter: %v", p)
+		}
+		if mode == "&rest" {
+			env.vars[sym.value] = sliceToList(args[i:])
+			i = len(args)
+		} else if i < len(args) {
+			env.vars[sym.value] = args[i]
+			i++
+		} else if mode == "&optional" {
+			env.vars[sym.value] = nil
+		} else {
+			return nil, errors.New("too few arguments")
+		}
+	}
+	if i < len(args) {
+		return nil, errors.New("too many arguments")
+	}
+	return c.m.progn(c.body, env)
+}
+
+func listToSlice(sxpr any) ([]any, error) {
+	var list []any
+	for sxpr != nil {
 		c, ok := sxpr.(*cons)
 		if !ok {
-			break
+			return nil, errors.New("not a list")
 		}
-		if cc, ok := c.car.(*cons); ok {
-			result, err := evalSxList(funcs, cc)
+		list = append(list, c.car)
+		sxpr = c.cdr
+	}
+	return list, nil
+}
+
+func sliceToList(list []any) any {
+	var result any
+	for i := len(list) - 1; i >= 0; i-- {
+		result = &cons{car: list[i], cdr: result}
+	}
+	return result
+}
+
+func (m *lispMachine) eval(sxpr any, env *lispEnv) (any, error) {
+	switch v := sxpr.(type) {
+	case symbol:
+		if e, ok := env.find(v.value); ok {
+			return e.vars[v.value], nil
+		}
+		return nil, fmt.Errorf("void variable: %s", v.value)
+	case *cons:
+		if m.depth >= lispMaxDepth {
+			return nil, errLispTooDeep
+		}
+		m.depth++
+		defer func() { m.depth-- }()
+		return m.evalForm(v, env)
+	default:
+		return sxpr, nil
+	}
+}
+
+func (m *lispMachine) progn(forms []any, env *lispEnv) (result any, err error) {
+	for _, form := range forms {
+		if result, err = m.eval(form, env); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+func (m *lispMachine) evalLet(args []any, env *lispEnv, sequential bool) (any, error) {
+	if len(args) < 1 {
+		return nil, errors.New("let: too few arguments")
+	}
+	bindings, err := listToSlice(args[0])
+	if err != nil {
+		return nil, err
+	}
+	newEnv := newLispEnv(env)
+	for _, b := range bindings {
+		var name symbol
+		var value any
+		switch v := b.(type) {
+		case symbol:
+			name = v
+		case *cons:
+			list, err := listToSlice(v)
+			if err != nil || len(list) > 2 {
+				return nil, fmt.Errorf("let: invalid binding: %v", b)
+			}
+			var ok bool
+			if name, ok = list[0].(symbol); !ok {
+				return nil, fmt.Errorf("let: invalid binding: %v", b)
+			}
+			if len(list) == 2 {
+				scope := env
+				if sequential {
+					scope = newEnv
+				}
+				if value, err = m.eval(list[1], scope); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			return nil, fmt.Errorf("let: invalid binding: %v", b)
+		}
+		newEnv.vars[name.value] = value
+	}
+	return m.progn(args[1:], newEnv)
+}
+
+// call calls the closure or the function named by the symbol
+func (m *lispMachine) call(f any, args []any) (any, error) {
+	switch v := f.(type) {
+	case *lispClosure:
+		return v.call(args)
+	case symbol:
+		if f, ok := m.funcs[v.value]; ok {
+			return f(args)
+		}
+		return nil, fmt.Errorf("no such a function: %s", v.value)
+	default:
+		return nil, fmt.Errorf("invalid function: %v", f)
+	}
+}
+
+func (m *lispMachine) evalForm(form *cons, env *lispEnv) (any, error) {
+	args, err := listToSlice(form.cdr)
+	if err != nil {
+		return nil, err
+	}
+	sym, isSymbol := form.car.(symbol)
+	if isSymbol {
+		switch sym.value {
+		case "quote":
+			if len(args) != 1 {
+				return nil, errors.New("quote: argc error")
+			}
+			return args[0], nil
+		case "function":
+			if len(args) != 1 {
+				return nil, errors.New("function: argc error")
+			}
+			if c, ok := args[0].(*cons); ok && c.car == (symbol{value: "lambda"}) {
+				return m.eval(c, env)
+			}
+			return args[0], nil
+		case "lambda":
+			if len(args) < 1 {
+				return nil, errors.New("lambda: too few arguments")
+			}
+			return &lispClosure{params: args[0], body: args[1:], env: env, m: m}, nil
+		case "let", "let*":
+			return m.evalLet(args, env, sym.value == "let*")
+		case "if":
+			if len(args) < 2 {
+				return nil, errors.New("if: too few arguments")
+			}
+			cond, err := m.eval(args[0], env)
 			if err != nil {
 				return nil, err
 			}
-			list = append(list, result)
-		} else {
-			list = append(list, c.car)
+			if cond != nil {
+				return m.eval(args[1], env)
+			}
+			return m.progn(args[2:], env)
+		case "when", "unless":
+			if len(args) < 1 {
+				return nil, fmt.Errorf("%s: too few arguments", sym.value)
+			}
+			cond, err := m.eval(args[0], env)
+			if err != nil {
+				return nil, err
+			}
+			if (cond != nil) == (sym.value == "when") {
+				return m.progn(args[1:], env)
+			}
+			return nil, nil
+		case "cond":
+			for _, clause := range args {
+				list, err := listToSlice(clause)
+				if err != nil || len(list) < 1 {
+					return nil, fmt.Errorf("cond: invalid clause: %v", clause)
+				}
+				cond, err := m.eval(list[0], env)
+				if err != nil {
+					return nil, err
+				}
+				if cond != nil {
+					if len(list) == 1 {
+						return cond, nil
+					}
+					return m.progn(list[1:], env)
+				}
+			}
+			return nil, nil
+		case "progn":
+			return m.progn(args, env)
+		case "prog1":
+			if len(args) < 1 {
+				return nil, errors.New("prog1: too few arguments")
+			}
+			result, err := m.eval(args[0], env)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := m.progn(args[1:], env); err != nil {
+				return nil, err
+			}
+			return result, nil
+		case "and":
+			var result any = true
+			for _, arg := range args {
+				if result, err = m.eval(arg, env); err != nil || result == nil {
+					return nil, err
+				}
+			}
+			return result, nil
+		case "or":
+			for _, arg := range args {
+				if result, err := m.eval(arg, env); err != nil || result != nil {
+					return result, err
+				}
+			}
+			return nil, nil
+		case "setq":
+			if len(args)%2 != 0 {
+				return nil, errors.New("setq: odd number of arguments")
+			}
+			var value any
+			for i := 0; i < len(args); i += 2 {
+				name, ok := args[i].(symbol)
+				if !ok {
+					return nil, fmt.Errorf("setq: not a symbol: %v", args[i])
+				}
+				if value, err = m.eval(args[i+1], env); err != nil {
+					return nil, err
+				}
+				scope, ok := env.find(name.value)
+				if !ok {
+					scope = m.global
+				}
+				scope.vars[name.value] = value
+			}
+			return value, nil
 		}
-		sxpr = c.cdr
 	}
-	if len(list) < 1 {
-		return nil, errors.New("too few arguments")
+	values := make([]any, len(args))
+	for i, arg := range args {
+		if values[i], err = m.eval(arg, env); err != nil {
+			return nil, err
+		}
 	}
-	sym, ok := list[0].(symbol)
-	if !ok {
-		return nil, errors.New("not a symbol")
+	if isSymbol {
+		switch sym.value {
+		case "funcall":
+			if len(values) < 1 {
+				return nil, errors.New("funcall: too few arguments")
+			}
+			return m.call(values[0], values[1:])
+		case "apply":
+			if len(values) < 2 {
+				return nil, errors.New("apply: too few arguments")
+			}
+			rest, err := listToSlice(values[len(values)-1])
+			if err != nil {
+				return nil, err
+			}
+			return m.call(values[0], append(values[1:len(values)-1:len(values)-1], rest...))
+		}
+		return m.call(sym, values)
 	}
-	if f, ok := funcs[sym.value]; ok {
-		return f(list[1:])
+	// ((lambda (x) ...) arg)
+	f, err := m.eval(form.car, env)
+	if err != nil {
+		return nil, err
+	}
+	return m.call(f, values)
+}
+
+// lispString returns the value as princ of Emacs prints it
+func lispString(v any) string {
+	return printLisp(v, false)
+}
+
+// lispPrin1 returns the value as prin1 of Emacs prints it:
+// the strings are quoted.
+func lispPrin1(v any) string {
+	return printLisp(v, true)
+}
+
+var quoteLispString = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func printLisp(v any, quoted bool) string {
+	switch value := v.(type) {
+	case string:
+		if quoted {
+			return `"` + quoteLispString.Replace(value) + `"`
+		}
+		return value
+	case nil:
+		return "nil"
+	case bool:
+		return "t"
+	case symbol:
+		return value.value
+	case float64:
+		return formatLispFloat(value)
+	case *cons:
+		var buffer strings.Builder
+		buffer.WriteByte('(')
+		for {
+			buffer.WriteString(printLisp(value.car, quoted))
+			next, ok := value.cdr.(*cons)
+			if !ok {
+				if value.cdr != nil {
+					buffer.WriteString(" . ")
+					buffer.WriteString(printLisp(value.cdr, quoted))
+				}
+				break
+			}
+			buffer.WriteByte(' ')
+			value = next
+		}
+		buffer.WriteByte(')')
+		return buffer.String()
+	case *lispClosure:
+		return "#<closure>"
+	default:
+		return fmt.Sprint(v)
 	}
-	return nil, errors.New("no such a function")
 }
 
 func funConcat(args []any) (any, error) {
@@ -131,6 +540,11 @@ func funConcat(args []any) (any, error) {
 	}
 	s := buffer.String()
 	s = rxEscSeq.ReplaceAllStringFunc(s, func(ss string) string {
+		if ss[1] == 'u' || ss[1] == 'U' {
+			// \uXXXX and \U00XXXXXX
+			code, _ := strconv.ParseUint(ss[2:], 16, 32)
+			return string(rune(code))
+		}
 		var oct rune = 0
 		var b strings.Builder
 		for _, c := range ss[1:] {
@@ -146,47 +560,164 @@ func funPwd([]any) (any, error) {
 	return os.Getwd()
 }
 
-func funCurrentTimeString([]any) (any, error) {
-	return time.Now().Format(time.ANSIC), nil
-}
-
-func funCurrentDate([]any) (any, error) {
-	return time.Now().Format("2006年01月02日"), nil
-}
-
+// funSubstring is substring of Emacs. The indices are in characters,
+// and the negative ones count from the end.
 func funSubstring(args []any) (any, error) {
-	if len(args) != 3 {
-		return nil, errors.New("substr: argc error")
+	if len(args) < 1 || len(args) > 3 {
+		return nil, errors.New("substring: argc error")
 	}
 	s, ok := args[0].(string)
 	if !ok {
-		return nil, errors.New("substr: not a string")
+		return nil, errors.New("substring: not a string")
+	}
+	runes := []rune(s)
+	index := func(i int, def int) (int, error) {
+		if i >= len(args) || args[i] == nil {
+			return def, nil
+		}
+		n, ok := args[i].(int64)
+		if !ok {
+			return 0, fmt.Errorf("substring: not an integer: %v", args[i])
+		}
+		if n < 0 {
+			n += int64(len(runes))
+		}
+		if n < 0 || n > int64(len(runes)) {
+			return 0, fmt.Errorf("substring: args out of range: %v (len=%d)", args[i], len(runes))
+		}
+		return int(n), nil
 	}
-	start, ok := args[1].(int64)
-	if !ok || start < 0 || start >= int64(len(s)) {
-		return nil, fmt.Errorf("substr: start index: %v (len=%d)", args[1], len(s))
+	start, err := index(1, 0)
+	if err != nil {
+		return nil, err
 	}
-	end, ok := args[2].(int64)
-	if !ok || end < start || end >= int64(len(s)) {
-		return nil, fmt.Errorf("substr: end index: %v (len=%d)", args[2], len(s))
+	end, err := index(2, len(runes))
+	if err != nil {
+		return nil, err
 	}
-	return s[start:end], nil
+	if end < start {
+		return nil, fmt.Errorf("substring: args out of range: %d %d", start, end)
+	}
+	return string(runes[start:end]), nil
 }
 
 func funSkkVersion(args []any) (any, error) {
 	return "go-readline-skk", nil
 }
 
+func lispBool(b bool) any {
+	if b {
+		return true
+	}
+	return nil
+}
+
+func funNull(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("null: argc error")
+	}
+	return lispBool(args[0] == nil), nil
+}
+
+func funEqual(args []any) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.New("equal: argc error")
+	}
+	return lispBool(lispString(args[0]) == lispString(args[1]) &&
+		fmt.Sprintf("%T", args[0]) == fmt.Sprintf("%T", args[1])), nil
+}
+
+func funList(args []any) (any, error) {
+	return sliceToList(args), nil
+}
+
 var lispFunctions = map[string]func([]any) (any, error){
-	"concat":              funConcat,
-	"pwd":                 funPwd,
-	"current-time-string": funCurrentTimeString,
-	"skk-current-date":    funCurrentDate,
-	"substring":           funSubstring,
-	"skk-version":         funSkkVersion,
+	"concat":      funConcat,
+	"pwd":         funPwd,
+	"substring":   funSubstring,
+	"skk-version": funSkkVersion,
+	"null":        funNull,
+	"not":         funNull,
+	"eq":          funEqual,
+	"equal":       funEqual,
+	"list":        funList,
+
+	"format":           funFormat,
+	"number-to-string": funNumberToString,
+	"string-to-number": funStringToNumber,
+	"make-string":      funMakeString,
+	"upcase":           funUpcase,
+	"downcase":         funDowncase,
+	"car":              funCar,
+	"cdr":              funCdr,
+	"nth":              funNth,
+	"length":           funLength,
+	"string=":          funStringEqual,
+	"string-equal":     funStringEqual,
+	"+":                funPlus,
+	"-":                funMinus,
+	"*":                funTimes,
+	"/":                funDivide,
+	"%":                funMod,
+	"mod":              funMod,
+	"1+":               funOnePlus,
+	"1-":               funOneMinus,
+	"=":                funNumEqual,
+	"/=":               funNumNotEqual,
+	"<":                funLess,
+	">":                funGreater,
+	"<=":               funLessEqual,
+	">=":               funGreaterEqual,
+	"max":              funMax,
+	"min":              funMin,
+	"abs":              funAbs,
+	"float":            funFloat,
+	"truncate":         funTruncate,
 }
 
+// evalSxString returns the candidate evaluating the S-expression each time
+// it is shown. A lambda expression is called without arguments.
+// When the evaluation fails, the source itself is shown.
 func evalSxString(source string) candidateT {
+	return evalSxStringWithContext(source, nil)
+}
+
+// lispContext is where the Lisp candidates of a reading are evaluated
+type lispContext struct {
+	vars map[string]any   // the global variables like skk-henkan-key
+	now  func() time.Time // the clock of the date functions
+}
+
+// lispContext returns where the Lisp candidates of the reading are
+// evaluated: the variables of ddskk and the clock of Config.Now.
+func (M *Mode) lispContext(source string, numbers []string) *lispContext {
+	list := make([]any, len(numbers))
+	for i, n := range numbers {
+		list[i] = n
+	}
+	return &lispContext{
+		vars: map[string]any{
+			"skk-henkan-key":   source,
+			"skk-num-list":     sliceToList(list),
+			"skk-date-ad":      lispBool(M.dateAD),
+			"skk-number-style": int64(M.numberStyle),
+		},
+		now: M.now,
+	}
+}
+
+// bindLispContext replaces the Lisp candidates with the ones evaluated
+// in lc. The list is modified in place.
+func bindLispContext(list []candidateT, lc *lispContext) {
+	for i, c := range list {
+		if _, ok := c.(*candidateFuncT); ok {
+			list[i] = parseCandidateWithContext(c.Source(), lc)
+		}
+	}
+}
+
+// evalSxStringWithContext is evalSxString evaluating in lc
+func evalSxStringWithContext(source string, lc *lispContext) candidateT {
 	sxpr, err := parser1.Read(strings.NewReader(source))
 	if err != nil {
 		return candidateStringT(source)
@@ -194,11 +725,23 @@ func evalSxString(source string) candidateT {
 	return &candidateFuncT{
 		source: source,
 		f: func() string {
-			result, err := evalSxList(lispFunctions, sxpr)
+			m := newLispMachine(lispFunctions)
+			if lc != nil {
+				for name, value := range lc.vars {
+					m.global.vars[name] = value
+				}
+				if lc.now != nil {
+					m.now = lc.now
+				}
+			}
+			result, err := m.eval(sxpr, m.global)
+			if c, ok := result.(*lispClosure); ok && err == nil {
+				result, err = c.call(nil)
+			}
 			if err != nil {
 				return source
 			}
-			return fmt.Sprint(result)
+			return lispString(result)
 		},
 	}
 }
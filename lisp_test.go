@@ -0,0 +1,89 @@
+package skk
+
+import (
+	"testing"
+)
+
+func TestEvalSxString(t *testing.T) {
+	tests := map[string]string{
+		`(concat "a" "b")`:                                             "ab",
+		`(if nil "a" "b" "c")`:                                         "c",
+		`(if (null nil) "a" "b")`:                                      "a",
+		`(let ((a "x") (b "y")) (concat b a))`:                         "yx",
+		`(let ((a "x")) (let* ((a "y") (b a)) b))`:                     "y",
+		`(let ((a "x")) (let ((a "y") (b a)) b))`:                      "x",
+		`(progn "a" "b")`:                                              "b",
+		`(lambda () "lambda")`:                                         "lambda",
+		`((lambda (a &optional b) (concat a (if b b "-"))) "x")`:       "x-",
+		`(funcall (lambda (&rest args) (apply 'concat args)) "a" "b")`: "ab",
+		`(let ((f (let ((x "closure")) (lambda () x)))) (funcall f))`:  "closure",
+		`(cond ((equal "a" "b") "1") ((equal "a" "a") "2"))`:           "2",
+		`(let (x) (setq x "set") x)`:                                   "set",
+		`(and "a" "b")`:                                                "b",
+		`(or nil "b")`:                                                 "b",
+		`(when nil "a")`:                                               "nil",
+		`(unknown-function "a")`:                                       `(unknown-function "a")`,
+		`(concat void-variable)`:                                       `(concat void-variable)`,
+		`(let ((f nil)) (setq f (lambda () (funcall f))) (funcall f))`: `(let ((f nil)) (setq f (lambda () (funcall f))) (funcall f))`,
+	}
+	for source, expected := range tests {
+		if result := evalSxString(source).String(); result != expected {
+			t.Errorf("%s: expect %q, but %q", source, expected, result)
+		}
+	}
+}
+
+func TestLispFunctions(t *testing.T) {
+	tests := map[string]string{
+		`(format "%d年" 2026)`: "2026年",
+		`(format "%03d|%-4s|%5.2f|%x|%c|%S|%%" 7 "ab" 3.14159 255 ?A "q")`: `007|ab  | 3.14|ff|A|"q"|%`,
+		`(number-to-string (+ 1 2))`:                                       "3",
+		`(number-to-string (/ 7 2.0))`:                                     "3.5",
+		`(number-to-string (float 2))`:                                     "2.0",
+		`(number-to-string (string-to-number "12abc"))`:                    "12",
+		`(number-to-string (string-to-number "1.5"))`:                      "1.5",
+		`(number-to-string (string-to-number "ff" 16))`:                    "255",
+		`(number-to-string (string-to-number "abc"))`:                      "0",
+		`(make-string 3 ?a)`:                                               "aaa",
+		`(upcase "abc")`:                                                   "ABC",
+		`(downcase "ABC")`:                                                 "abc",
+		`(car '("a" "b"))`:                                                 "a",
+		`(car (cdr (list "a" "b")))`:                                       "b",
+		`(nth 2 '("a" "b" "c"))`:                                           "c",
+		`(nth 3 '("a" "b" "c"))`:                                           "nil",
+		`(nth -1 '("a" "b" "c"))`:                                          "a",
+		`(make-string 100000000 ?a)`:                                       `(make-string 100000000 ?a)`,
+		`(substring "漢字変換" 1 3)`:                                           "字変",
+		`(substring "漢字変換" -2)`:                                            "変換",
+		`(let ((s "abc123def")) (if (string-match "[0-9]+" s) (match-string 0 s)))`:                         "123",
+		`(let ((s "かな12")) (format "%d" (string-match "\\([0-9]\\)\\([0-9]\\)" s)))`:                        "2",
+		`(progn (string-match "\\(a\\|b\\)+" "xxabba") (format "%d-%d" (match-beginning 0) (match-end 1)))`: "2-6",
+		`(let ((s "  漢字abc、")) (string-match "\\sw+" s) (match-string 0 s))`:                                "漢字abc",
+		`(let ((s "漢字 かな")) (string-match "\\Sw\\w+" s) (match-string 0 s))`:                                " かな",
+		`(let ((s "a b")) (string-match "\\s-" s) (format "%d" (match-beginning 0)))`:                       "1",
+		`(replace-regexp-in-string "\\([a-z]+\\)-\\([a-z]+\\)" "\\2-\\1" "foo-bar")`:                        "bar-foo",
+		`(replace-regexp-in-string "[0-9]" (lambda (s) (concat "<" s ">")) "a1b2")`:                         "a<1>b<2>",
+		`(replace-regexp-in-string "o" "\\&" "foo" nil t)`:                                                  `f\&\&`,
+		`(number-to-string (- 10 3 2))`:                                                                     "5",
+		`(number-to-string (- 4))`:                                                                          "-4",
+		`(number-to-string (* 2 3 4))`:                                                                      "24",
+		`(number-to-string (mod -7 3))`:                                                                     "2",
+		`(number-to-string (% 7 3))`:                                                                        "1",
+		`(number-to-string (1+ (1- 5)))`:                                                                    "5",
+		`(number-to-string (max 1 5 3))`:                                                                    "5",
+		`(number-to-string (min 1 5 3))`:                                                                    "1",
+		`(number-to-string (abs -3))`:                                                                       "3",
+		`(number-to-string (truncate 7 2))`:                                                                 "3",
+		`(if (< 1 2 3) "yes" "no")`:                                                                         "yes",
+		`(if (>= 1 2) "yes" "no")`:                                                                          "no",
+		`(if (= 2 2.0) "yes" "no")`:                                                                         "yes",
+		`(if (string= "a" "a") "yes" "no")`:                                                                 "yes",
+		`(number-to-string (length "漢字"))`:                                                                  "2",
+		`(/ 1 0)`:                                                                                           `(/ 1 0)`,
+	}
+	for source, expected := range tests {
+		if result := evalSxString(source).String(); result != expected {
+			t.Errorf("%s: expect %q, but %q", source, expected, result)
+		}
+	}
+}
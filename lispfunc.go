@@ -0,0 +1,805 @@
+package skk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func formatLispFloat(f float64) string {
+	if math.IsInf(f, 1) {
+		return "1.0e+INF"
+	}
+	if math.IsInf(f, -1) {
+		return "-1.0e+INF"
+	}
+	if math.IsNaN(f) {
+		return "0.0e+NaN"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// lispInt returns the integer of the number or the character
+func lispInt(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case rune:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// lispNumbers returns the arguments as floats and as integers.
+// isFloat is true when any of them is a float.
+func lispNumbers(name string, args []any) (floats []float64, ints []int64, isFloat bool, err error) {
+	floats = make([]float64, len(args))
+	ints = make([]int64, len(args))
+	for i, arg := range args {
+		switch n := arg.(type) {
+		case int64:
+			floats[i], ints[i] = float64(n), n
+		case rune:
+			floats[i], ints[i] = float64(n), int64(n)
+		case float64:
+			floats[i], ints[i] = n, int64(n)
+			isFloat = true
+		default:
+			return nil, nil, false, fmt.Errorf("%s: not a number: %s", name, lispPrin1(arg))
+		}
+	}
+	return
+}
+
+var rxFormatSpec = regexp.MustCompile(`%([-+ #0]*)([0-9]*)(\.[0-9]+)?(.)`)
+
+// funFormat is format of Emacs supporting %s, %S, %d, %o, %x, %X, %c,
+// %e, %f, %g and %% with the flags, the width and the precision.
+func funFormat(args []any) (any, error) {
+	if len(args) < 1 {
+		return nil, errors.New("format: too few arguments")
+	}
+	f, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("format: not a string")
+	}
+	args = args[1:]
+	var err error
+	result := rxFormatSpec.ReplaceAllStringFunc(f, func(spec string) string {
+		m := rxFormatSpec.FindStringSubmatch(spec)
+		verb := m[4]
+		if verb == "%" {
+			return "%"
+		}
+		if len(args) <= 0 {
+			err = errors.New("format: not enough arguments for format string")
+			return ""
+		}
+		arg := args[0]
+		args = args[1:]
+		goSpec := "%" + m[1] + m[2] + m[3]
+		switch verb {
+		case "s":
+			return fmt.Sprintf(goSpec+"s", lispString(arg))
+		case "S":
+			return fmt.Sprintf(goSpec+"s", lispPrin1(arg))
+		case "d", "o", "x", "X", "c":
+			n, ok := lispInt(arg)
+			if !ok {
+				err = fmt.Errorf("format: not a number: %s", lispPrin1(arg))
+				return ""
+			}
+			if verb == "c" {
+				return fmt.Sprintf(goSpec+"c", rune(n))
+			}
+			return fmt.Sprintf(goSpec+verb, n)
+		case "e", "f", "g":
+			floats, _, _, err1 := lispNumbers("format", []any{arg})
+			if err1 != nil {
+				err = err1
+				return ""
+			}
+			return fmt.Sprintf(goSpec+verb, floats[0])
+		}
+		err = fmt.Errorf("format: invalid format operation %s", spec)
+		return ""
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func funNumberToString(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("number-to-string: argc error")
+	}
+	switch n := args[0].(type) {
+	case int64:
+		return strconv.FormatInt(n, 10), nil
+	case rune:
+		return strconv.FormatInt(int64(n), 10), nil
+	case float64:
+		return formatLispFloat(n), nil
+	}
+	return nil, fmt.Errorf("number-to-string: not a number: %s", lispPrin1(args[0]))
+}
+
+var rxLeadingNumber = regexp.MustCompile(`^[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?`)
+
+// funStringToNumber is string-to-number of Emacs: it parses the number
+// at the head of the string ignoring the rest, and returns 0 without it.
+func funStringToNumber(args []any) (any, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, errors.New("string-to-number: argc error")
+	}
+	s, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("string-to-number: not a string")
+	}
+	s = strings.TrimLeft(s, " \t")
+	base := int64(10)
+	if len(args) > 1 && args[1] != nil {
+		if base, ok = args[1].(int64); !ok || base < 2 || base > 16 {
+			return nil, fmt.Errorf("string-to-number: invalid base: %v", args[1])
+		}
+	}
+	if base != 10 {
+		sign := ""
+		if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+			sign, s = s[:1], s[1:]
+		}
+		end := 0
+		for end < len(s) {
+			d := strings.IndexByte("0123456789abcdef", byte(unicode.ToLower(rune(s[end]))))
+			if d < 0 || int64(d) >= base {
+				break
+			}
+			end++
+		}
+		n, err := strconv.ParseInt(sign+s[:end], int(base), 64)
+		if err != nil {
+			return int64(0), nil
+		}
+		return n, nil
+	}
+	m := rxLeadingNumber.FindStringSubmatch(s)
+	if m == nil {
+		return int64(0), nil
+	}
+	number := m[0]
+	if m[2] != "" || (strings.Contains(m[1], ".") && !strings.HasSuffix(m[1], ".")) {
+		f, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return int64(0), nil
+		}
+		return f, nil
+	}
+	n, err := strconv.ParseInt(strings.TrimSuffix(number, "."), 10, 64)
+	if err != nil {
+		return int64(0), nil
+	}
+	return n, nil
+}
+
+// maxMakeString is the upper limit of the count of make-string
+// not to exhaust the memory by a broken or malicious candidate.
+const maxMakeString = 4096
+
+func funMakeString(args []any) (any, error) {
+	if len(args) < 2 {
+		return nil, errors.New("make-string: too few arguments")
+	}
+	count, ok := args[0].(int64)
+	if !ok || count < 0 {
+		return nil, fmt.Errorf("make-string: invalid count: %v", args[0])
+	}
+	if count > maxMakeString {
+		return nil, fmt.Errorf("make-string: too large count: %d", count)
+	}
+	c, ok := lispInt(args[1])
+	if !ok {
+		return nil, fmt.Errorf("make-string: not a character: %v", args[1])
+	}
+	return strings.Repeat(string(rune(c)), int(count)), nil
+}
+
+func convertCase(name string, args []any, s func(string) string, r func(rune) rune) (any, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%s: argc error", name)
+	}
+	switch v := args[0].(type) {
+	case string:
+		return s(v), nil
+	case rune:
+		return r(v), nil
+	case int64:
+		return int64(r(rune(v))), nil
+	}
+	return nil, fmt.Errorf("%s: not a string or a character: %s", name, lispPrin1(args[0]))
+}
+
+func funUpcase(args []any) (any, error) {
+	return convertCase("upcase", args, strings.ToUpper, unicode.ToUpper)
+}
+
+func funDowncase(args []any) (any, error) {
+	return convertCase("downcase", args, strings.ToLower, unicode.ToLower)
+}
+
+func funCar(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("car: argc error")
+	}
+	if args[0] == nil {
+		return nil, nil
+	}
+	c, ok := args[0].(*cons)
+	if !ok {
+		return nil, fmt.Errorf("car: not a list: %s", lispPrin1(args[0]))
+	}
+	return c.car, nil
+}
+
+func funCdr(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("cdr: argc error")
+	}
+	if args[0] == nil {
+		return nil, nil
+	}
+	c, ok := args[0].(*cons)
+	if !ok {
+		return nil, fmt.Errorf("cdr: not a list: %s", lispPrin1(args[0]))
+	}
+	return c.cdr, nil
+}
+
+func funNth(args []any) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.New("nth: argc error")
+	}
+	n, ok := args[0].(int64)
+	if !ok {
+		return nil, fmt.Errorf("nth: not an integer: %s", lispPrin1(args[0]))
+	}
+	list := args[1]
+	for ; list != nil; n-- {
+		c, ok := list.(*cons)
+		if !ok {
+			return nil, fmt.Errorf("nth: not a list: %s", lispPrin1(args[1]))
+		}
+		// as Emacs, the negative index is the first element
+		if n <= 0 {
+			return c.car, nil
+		}
+		list = c.cdr
+	}
+	return nil, nil
+}
+
+func funLength(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("length: argc error")
+	}
+	switch v := args[0].(type) {
+	case string:
+		return int64(utf8.RuneCountInString(v)), nil
+	case []any:
+		return int64(len(v)), nil
+	}
+	list, err := listToSlice(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("length: %w", err)
+	}
+	return int64(len(list)), nil
+}
+
+func funStringEqual(args []any) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.New("string=: argc error")
+	}
+	return lispBool(lispString(args[0]) == lispString(args[1])), nil
+}
+
+// lispArith folds the arguments with the operator on integers
+// unless any of them is a float.
+func lispArith(name string, args []any, intOp func(a, b int64) (int64, error), floatOp func(a, b float64) float64) (any, error) {
+	floats, ints, isFloat, err := lispNumbers(name, args)
+	if err != nil {
+		return nil, err
+	}
+	if isFloat {
+		result := floats[0]
+		for _, f := range floats[1:] {
+			result = floatOp(result, f)
+		}
+		return result, nil
+	}
+	result := ints[0]
+	for _, n := range ints[1:] {
+		if result, err = intOp(result, n); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+var errArithDomain = errors.New("arith-error")
+
+func funPlus(args []any) (any, error) {
+	return lispArith("+", append([]any{int64(0)}, args...),
+		func(a, b int64) (int64, error) { return a + b, nil },
+		func(a, b float64) float64 { return a + b })
+}
+
+func funMinus(args []any) (any, error) {
+	if len(args) == 1 {
+		args = []any{int64(0), args[0]}
+	} else if len(args) == 0 {
+		return int64(0), nil
+	}
+	return lispArith("-", args,
+		func(a, b int64) (int64, error) { return a - b, nil },
+		func(a, b float64) float64 { return a - b })
+}
+
+func funTimes(args []any) (any, error) {
+	return lispArith("*", append([]any{int64(1)}, args...),
+		func(a, b int64) (int64, error) { return a * b, nil },
+		func(a, b float64) float64 { return a * b })
+}
+
+func funDivide(args []any) (any, error) {
+	if len(args) == 1 {
+		args = []any{int64(1), args[0]}
+	} else if len(args) == 0 {
+		return nil, errors.New("/: too few arguments")
+	}
+	return lispArith("/", args,
+		func(a, b int64) (int64, error) {
+			if b == 0 {
+				return 0, errArithDomain
+			}
+			return a / b, nil
+		},
+		func(a, b float64) float64 { return a / b })
+}
+
+// funMod is mod of Emacs: the result has the sign of the divisor.
+func funMod(args []any) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.New("mod: argc error")
+	}
+	return lispArith("mod", args,
+		func(a, b int64) (int64, error) {
+			if b == 0 {
+				return 0, errArithDomain
+			}
+			r := a % b
+			if r != 0 && (r < 0) != (b < 0) {
+				r += b
+			}
+			return r, nil
+		},
+		func(a, b float64) float64 {
+			r := math.Mod(a, b)
+			if r != 0 && (r < 0) != (b < 0) {
+				r += b
+			}
+			return r
+		})
+}
+
+func funOnePlus(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("1+: argc error")
+	}
+	return funPlus([]any{args[0], int64(1)})
+}
+
+func funOneMinus(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("1-: argc error")
+	}
+	return funMinus([]any{args[0], int64(1)})
+}
+
+// lispCompare reports whether each pair of the adjacent arguments
+// satisfies the comparison.
+func lispCompare(name string, args []any, cmp func(a, b float64) bool) (any, error) {
+	if len(args) < 1 {
+		return nil, fmt.Errorf("%s: too few arguments", name)
+	}
+	floats, _, _, err := lispNumbers(name, args)
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(floats); i++ {
+		if !cmp(floats[i-1], floats[i]) {
+			return nil, nil
+		}
+	}
+	return true, nil
+}
+
+func funNumEqual(args []any) (any, error) {
+	return lispCompare("=", args, func(a, b float64) bool { return a == b })
+}
+
+func funNumNotEqual(args []any) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.New("/=: argc error")
+	}
+	return lispCompare("/=", args, func(a, b float64) bool { return a != b })
+}
+
+func funLess(args []any) (any, error) {
+	return lispCompare("<", args, func(a, b float64) bool { return a < b })
+}
+
+func funGreater(args []any) (any, error) {
+	return lispCompare(">", args, func(a, b float64) bool { return a > b })
+}
+
+func funLessEqual(args []any) (any, error) {
+	return lispCompare("<=", args, func(a, b float64) bool { return a <= b })
+}
+
+func funGreaterEqual(args []any) (any, error) {
+	return lispCompare(">=", args, func(a, b float64) bool { return a >= b })
+}
+
+func funMax(args []any) (any, error) {
+	if len(args) < 1 {
+		return nil, errors.New("max: too few arguments")
+	}
+	return lispArith("max", args,
+		func(a, b int64) (int64, error) {
+			if b > a {
+				return b, nil
+			}
+			return a, nil
+		}, math.Max)
+}
+
+func funMin(args []any) (any, error) {
+	if len(args) < 1 {
+		return nil, errors.New("min: too few arguments")
+	}
+	return lispArith("min", args,
+		func(a, b int64) (int64, error) {
+			if b < a {
+				return b, nil
+			}
+			return a, nil
+		}, math.Min)
+}
+
+func funAbs(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("abs: argc error")
+	}
+	floats, ints, isFloat, err := lispNumbers("abs", args)
+	if err != nil {
+		return nil, err
+	}
+	if isFloat {
+		return math.Abs(floats[0]), nil
+	}
+	if ints[0] < 0 {
+		return -ints[0], nil
+	}
+	return ints[0], nil
+}
+
+func funFloat(args []any) (any, error) {
+	if len(args) != 1 {
+		return nil, errors.New("float: argc error")
+	}
+	floats, _, _, err := lispNumbers("float", args)
+	if err != nil {
+		return nil, err
+	}
+	return floats[0], nil
+}
+
+func funTruncate(args []any) (any, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, errors.New("truncate: argc error")
+	}
+	value := args[0]
+	if len(args) == 2 && args[1] != nil {
+		var err error
+		if value, err = funDivide(args); err != nil {
+			return nil, err
+		}
+	}
+	_, ints, _, err := lispNumbers("truncate", []any{value})
+	if err != nil {
+		return nil, err
+	}
+	return ints[0], nil
+}
+
+// emacsWordClass is the word syntax of Emacs, which contains the letters
+// of Japanese unlike \w of Go.
+const (
+	emacsWordClass    = `[\p{L}\p{N}_]`
+	emacsNotWordClass = `[^\p{L}\p{N}_]`
+)
+
+// emacsSyntaxClasses are the classes of \sC and \SC by C
+var emacsSyntaxClasses = map[byte][2]string{
+	'-': {`\s`, `\S`},
+	' ': {`\s`, `\S`},
+	'w': {emacsWordClass, emacsNotWordClass},
+	'.': {`\pP`, `\PP`},
+}
+
+// emacsRegexp compiles the regular expression of Emacs
+// (`\(...\)`, `\|`, `\{n,m\}`...) as the one of Go.
+// The back references are not supported.
+func emacsRegexp(re string) (*regexp.Regexp, error) {
+	var buffer strings.Builder
+	for i := 0; i < len(re); i++ {
+		c := re[i]
+		switch {
+		case c == '[':
+			// a backslash is not special in the brackets of Emacs
+			j := i + 1
+			if j < len(re) && re[j] == '^' {
+				j++
+			}
+			if j < len(re) && re[j] == ']' {
+				j++
+			}
+			for j < len(re) && re[j] != ']' {
+				if strings.HasPrefix(re[j:], "[:") {
+					if k := strings.Index(re[j+2:], ":]"); k >= 0 {
+						j += k + 4
+						continue
+					}
+				}
+				j++
+			}
+			if j >= len(re) {
+				return nil, fmt.Errorf("unmatched [ in %s", re)
+			}
+			buffer.WriteString(strings.ReplaceAll(re[i:j+1], `\`, `\\`))
+			i = j
+		case c == '\\' && i+1 < len(re):
+			i++
+			switch d := re[i]; d {
+			case '(':
+				if strings.HasPrefix(re[i+1:], "?:") {
+					i += 2
+					buffer.WriteString("(?:")
+				} else {
+					buffer.WriteByte('(')
+				}
+			case ')', '|', '{', '}':
+				buffer.WriteByte(d)
+			case '`':
+				buffer.WriteString(`\A`)
+			case '\'':
+				buffer.WriteString(`\z`)
+			case 'w':
+				buffer.WriteString(emacsWordClass)
+			case 'W':
+				buffer.WriteString(emacsNotWordClass)
+			case 'b', 'B':
+				buffer.WriteByte('\\')
+				buffer.WriteByte(d)
+			case 's', 'S':
+				// \sC is the syntax class C: \s- (or "\s ") whitespace,
+				// \sw word and \s. punctuation
+				if i+1 >= len(re) {
+					return nil, fmt.Errorf("no syntax class: %s", re)
+				}
+				i++
+				class, ok := emacsSyntaxClasses[re[i]]
+				if !ok {
+					return nil, fmt.Errorf("syntax class not supported: \\%c%c", d, re[i])
+				}
+				if d == 's' {
+					buffer.WriteString(class[0])
+				} else {
+					buffer.WriteString(class[1])
+				}
+			default:
+				if '1' <= d && d <= '9' {
+					return nil, fmt.Errorf("back reference not supported: %s", re)
+				}
+				buffer.WriteString(regexp.QuoteMeta(string(d)))
+			}
+		case strings.IndexByte("(){}|", c) >= 0:
+			buffer.WriteByte('\\')
+			buffer.WriteByte(c)
+		default:
+			buffer.WriteByte(c)
+		}
+	}
+	return regexp.Compile(buffer.String())
+}
+
+// runeIndices converts the byte positions of the submatches in s into
+// the character positions like Emacs.
+func runeIndices(s string, loc []int) []int {
+	result := make([]int, len(loc))
+	for i, pos := range loc {
+		if pos < 0 {
+			result[i] = -1
+		} else {
+			result[i] = utf8.RuneCountInString(s[:pos])
+		}
+	}
+	return result
+}
+
+// funStringMatch is string-match of Emacs. It keeps the match data
+// for match-string, match-beginning and match-end.
+func (m *lispMachine) funStringMatch(args []any) (any, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, errors.New("string-match: argc error")
+	}
+	pattern, ok1 := args[0].(string)
+	s, ok2 := args[1].(string)
+	if !ok1 || !ok2 {
+		return nil, errors.New("string-match: not a string")
+	}
+	re, err := emacsRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("string-match: %w", err)
+	}
+	start := 0
+	if len(args) > 2 && args[2] != nil {
+		n, ok := args[2].(int64)
+		runes := []rune(s)
+		if !ok || n < 0 || n > int64(len(runes)) {
+			return nil, fmt.Errorf("string-match: args out of range: %v", args[2])
+		}
+		start = len(string(runes[:n]))
+	}
+	loc := re.FindStringSubmatchIndex(s[start:])
+	if loc == nil {
+		return nil, nil
+	}
+	for i := range loc {
+		if loc[i] >= 0 {
+			loc[i] += start
+		}
+	}
+	m.matchData = runeIndices(s, loc)
+	return int64(m.matchData[0]), nil
+}
+
+func (m *lispMachine) matchPosition(name string, args []any, end int) (any, error) {
+	if len(args) < 1 {
+		return nil, fmt.Errorf("%s: too few arguments", name)
+	}
+	n, ok := args[0].(int64)
+	if !ok || n < 0 {
+		return nil, fmt.Errorf("%s: invalid subexpression: %v", name, args[0])
+	}
+	if i := int(n)*2 + end; i < len(m.matchData) && m.matchData[i] >= 0 {
+		return int64(m.matchData[i]), nil
+	}
+	return nil, nil
+}
+
+func (m *lispMachine) funMatchBeginning(args []any) (any, error) {
+	return m.matchPosition("match-beginning", args, 0)
+}
+
+func (m *lispMachine) funMatchEnd(args []any) (any, error) {
+	return m.matchPosition("match-end", args, 1)
+}
+
+// funMatchString is match-string of Emacs for the string
+// given to string-match (the buffers are not supported).
+func (m *lispMachine) funMatchString(args []any) (any, error) {
+	if len(args) != 2 {
+		return nil, errors.New("match-string: argc error")
+	}
+	s, ok := args[1].(string)
+	if !ok {
+		return nil, errors.New("match-string: not a string")
+	}
+	start, err := m.funMatchBeginning(args[:1])
+	if err != nil || start == nil {
+		return nil, err
+	}
+	end, _ := m.funMatchEnd(args[:1])
+	return funSubstring([]any{s, start, end})
+}
+
+// expandReplacement replaces `\&` and `\N` in rep with the match and
+// the submatches of s at loc (the byte positions).
+func expandReplacement(rep, s string, loc []int) (string, error) {
+	var buffer strings.Builder
+	for i := 0; i < len(rep); i++ {
+		if rep[i] != '\\' {
+			buffer.WriteByte(rep[i])
+			continue
+		}
+		i++
+		if i >= len(rep) {
+			return "", errors.New("trailing backslash in the replacement")
+		}
+		switch d := rep[i]; {
+		case d == '&':
+			buffer.WriteString(s[loc[0]:loc[1]])
+		case '0' <= d && d <= '9':
+			if n := int(d-'0') * 2; n+1 < len(loc) && loc[n] >= 0 {
+				buffer.WriteString(s[loc[n]:loc[n+1]])
+			}
+		case d == '\\':
+			buffer.WriteByte('\\')
+		default:
+			return "", fmt.Errorf(`invalid use of \ in the replacement: \%c`, d)
+		}
+	}
+	return buffer.String(), nil
+}
+
+// funReplaceRegexpInString is replace-regexp-in-string of Emacs.
+// REP is a string or a function called with the match.
+// The case of the replacement is not converted (FIXEDCASE is ignored),
+// and SUBEXP and START are not supported.
+func (m *lispMachine) funReplaceRegexpInString(args []any) (any, error) {
+	if len(args) < 3 {
+		return nil, errors.New("replace-regexp-in-string: too few arguments")
+	}
+	if len(args) > 5 {
+		return nil, errors.New("replace-regexp-in-string: SUBEXP and START are not supported")
+	}
+	pattern, ok1 := args[0].(string)
+	s, ok2 := args[2].(string)
+	if !ok1 || !ok2 {
+		return nil, errors.New("replace-regexp-in-string: not a string")
+	}
+	literal := len(args) > 4 && args[4] != nil
+	re, err := emacsRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("replace-regexp-in-string: %w", err)
+	}
+	var buffer strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringSubmatchIndex(s, -1) {
+		buffer.WriteString(s[last:loc[0]])
+		last = loc[1]
+		var rep string
+		if r, ok := args[1].(string); ok {
+			rep = r
+		} else {
+			m.matchData = runeIndices(s, loc)
+			value, err := m.call(args[1], []any{s[loc[0]:loc[1]]})
+			if err != nil {
+				return nil, err
+			}
+			if rep, ok = value.(string); !ok {
+				return nil, fmt.Errorf("replace-regexp-in-string: not a string: %s", lispPrin1(value))
+			}
+		}
+		if !literal {
+			if rep, err = expandReplacement(rep, s, loc); err != nil {
+				return nil, fmt.Errorf("replace-regexp-in-string: %w", err)
+			}
+		}
+		buffer.WriteString(rep)
+	}
+	buffer.WriteString(s[last:])
+	return buffer.String(), nil
+}
@@ -0,0 +1,54 @@
+package skk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	defaultLockTimeout = 5 * time.Second
+	lockRetryInterval  = 20 * time.Millisecond
+)
+
+// ErrLockTimeout is the error when the lock file of the user dictionary
+// can not be locked in time because another process keeps it.
+var ErrLockTimeout = errors.New("timeout to lock the user dictionary")
+
+// lockFile locks the advisory lock file `path` exclusively.
+// While another process has the lock, it retries until timeout.
+// The lock is the one of the OS (flock or LockFileEx), which is released
+// when the process crashes, so no stale lock is left. The file itself is
+// kept after unlocking not to lock a file removed by another process.
+func lockFile(path string, timeout time.Duration) (func() error, error) {
+	if timeout <= 0 {
+		timeout = defaultLockTimeout
+	}
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		locked, err := tryLockFile(fd)
+		if err != nil {
+			fd.Close()
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if locked {
+			return func() error {
+				err := unlockFile(fd)
+				if err1 := fd.Close(); err == nil {
+					err = err1
+				}
+				return err
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			fd.Close()
+			return nil, fmt.Errorf("%s: %w", path, ErrLockTimeout)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd || windows)
+
+package skk
+
+import (
+	"os"
+	"sync"
+)
+
+// lockedFiles are the files locked in this process. The platforms
+// without flock and LockFileEx lock only among the goroutines.
+var lockedFiles sync.Map
+
+func lockKey(fd *os.File) string {
+	return fd.Name()
+}
+
+func tryLockFile(fd *os.File) (bool, error) {
+	_, loaded := lockedFiles.LoadOrStore(lockKey(fd), struct{}{})
+	return !loaded, nil
+}
+
+func unlockFile(fd *os.File) error {
+	lockedFiles.Delete(lockKey(fd))
+	return nil
+}
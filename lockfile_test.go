@@ -0,0 +1,166 @@
+package skk
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentSave(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	err := os.WriteFile(userPath, []byte(";; -*- coding: utf-8 -*-\n"+nasiHeader+"\nかんじ /漢字/\n"), 0666)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// the modification time must differ from the ones of the saved files
+	// even on the file systems updating it coarsely
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(userPath, past, past); err != nil {
+		t.Fatal(err.Error())
+	}
+	const n = 8
+	modes := make([]*Mode, n)
+	for i := range modes {
+		modes[i], err = Config{UserJisyoPath: userPath, BindTo: dummyKeyMap{}}.Setup()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		modes[i].User.storeAndLearn(fmt.Sprintf("たんご%d", i), false,
+			[]candidateT{candidateStringT(fmt.Sprintf("単語%d", i))})
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := range modes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = modes[i].SaveUserJisyo()
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	result := newJisyo()
+	if err := result.Load(userPath); err != nil {
+		t.Fatal(err.Error())
+	}
+	for i := 0; i < n; i++ {
+		if _, ok := result.lookup(fmt.Sprintf("たんご%d", i), false); !ok {
+			t.Fatalf("たんご%d is lost", i)
+		}
+	}
+	if _, ok := result.lookup("かんじ", false); !ok {
+		t.Fatal("かんじ is lost")
+	}
+}
+
+func TestLockTimeout(t *testing.T) {
+	lockPath := filepath.Join(t.TempDir(), "user-jisyo.LOCK")
+	unlock, err := lockFile(lockPath, time.Second)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := lockFile(lockPath, 50*time.Millisecond); !errors.Is(err, ErrLockTimeout) {
+		t.Fatalf("expect ErrLockTimeout, but %v", err)
+	}
+	if err := unlock(); err != nil {
+		t.Fatal(err.Error())
+	}
+	unlock, err = lockFile(lockPath, 50*time.Millisecond)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	unlock()
+}
+
+func TestLockConcurrent(t *testing.T) {
+	lockPath := filepath.Join(t.TempDir(), "user-jisyo.LOCK")
+	var inside, maxInside int32
+	var wg sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				unlock, err := lockFile(lockPath, 10*time.Second)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				if n := atomic.AddInt32(&inside, 1); n > atomic.LoadInt32(&maxInside) {
+					atomic.StoreInt32(&maxInside, n)
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(&inside, -1)
+				if err := unlock(); err != nil {
+					errs[i] = err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if maxInside != 1 {
+		t.Fatalf("%d goroutines had the lock at the same time", maxInside)
+	}
+}
+
+// TestLockHelperProcess keeps the lock of LOCK_HELPER_PATH until killed
+func TestLockHelperProcess(t *testing.T) {
+	path := os.Getenv("LOCK_HELPER_PATH")
+	if path == "" {
+		t.Skip("helper process only")
+	}
+	if _, err := lockFile(path, time.Second); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	fmt.Println("locked")
+	time.Sleep(time.Minute)
+	os.Exit(0)
+}
+
+func TestLockReleasedByCrash(t *testing.T) {
+	lockPath := filepath.Join(t.TempDir(), "user-jisyo.LOCK")
+	cmd := exec.Command(os.Args[0], "-test.run=^TestLockHelperProcess$")
+	cmd.Env = append(os.Environ(), "LOCK_HELPER_PATH="+lockPath)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+	if line, _ := bufio.NewReader(stdout).ReadString('\n'); line != "locked\n" {
+		t.Fatalf("helper: %s", line)
+	}
+	if _, err := lockFile(lockPath, 50*time.Millisecond); !errors.Is(err, ErrLockTimeout) {
+		t.Fatalf("expect ErrLockTimeout while another process locks, but %v", err)
+	}
+	// the lock file is left, but the lock is released by the OS
+	cmd.Process.Kill()
+	cmd.Wait()
+	unlock, err := lockFile(lockPath, time.Second)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	unlock()
+}
@@ -0,0 +1,22 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package skk
+
+import (
+	"os"
+	"syscall"
+)
+
+// tryLockFile locks the file with flock(2) without blocking.
+// It returns false when another open file has the lock.
+func tryLockFile(fd *os.File) (bool, error) {
+	err := syscall.Flock(int(fd.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK || err == syscall.EINTR {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func unlockFile(fd *os.File) error {
+	return syscall.Flock(int(fd.Fd()), syscall.LOCK_UN)
+}
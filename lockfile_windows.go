@@ -0,0 +1,24 @@
+package skk
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// tryLockFile locks the first byte of the file with LockFileEx without
+// blocking. It returns false when another handle has the lock.
+func tryLockFile(fd *os.File) (bool, error) {
+	var ol windows.Overlapped
+	err := windows.LockFileEx(windows.Handle(fd.Fd()),
+		windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY, 0, 1, 0, &ol)
+	if err == windows.ERROR_LOCK_VIOLATION {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func unlockFile(fd *os.File) error {
+	var ol windows.Overlapped
+	return windows.UnlockFileEx(windows.Handle(fd.Fd()), 0, 1, 0, &ol)
+}
@@ -2,6 +2,7 @@ package skk
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -43,39 +44,46 @@ func (trig *_Trigger) String() string {
 
 // Mode is an instance of SKK. It contains system dictionaries and user dictionaries.
 type Mode struct {
-	User           *Jisyo
-	System         *Jisyo
-	MiniBuffer     MiniBuffer
-	saveMap        []readline.Command
-	kana           *_Kana
-	userJisyoPath  string
-	userJisyoStamp time.Time
-	ctrlJ          keys.Code
-}
-
-var rxNumber = regexp.MustCompile(`[0-9]+`)
-
-var rxToNumber = regexp.MustCompile(`#[0123459]`)
-
-var kansuji = map[rune]string{
-	'0': "〇",
-	'1': "一",
-	'2': "二",
-	'3': "三",
-	'4': "四",
-	'5': "五",
-	'6': "六",
-	'7': "七",
-	'8': "八",
-	'9': "九",
-}
-
-func numberToKanji(s string) string {
-	var buffer strings.Builder
-	for _, r := range s {
-		buffer.WriteString(kansuji[r])
-	}
-	return buffer.String()
+	User *Jisyo
+
+	// System is consulted after the system dictionaries.
+	//
+	// Deprecated: System does not have the dictionaries of
+	// Config.SystemJisyoPaths, which are kept per file, and is empty unless
+	// the application stores entries into it. Use SystemJisyoNames and
+	// SystemJisyo to access the system dictionaries.
+	System *Jisyo
+
+	MiniBuffer      MiniBuffer
+	saveMap         []readline.Command
+	kana            *_Kana
+	userJisyoPath   string
+	userJisyoStamp  time.Time
+	userJisyoCoding string
+	userBase        *Jisyo // the user dictionary on disk when loaded or saved last
+	ctrlJ           keys.Code
+	layers          []*jisyoLayer
+	annotation      bool
+	showOrigin      bool
+	reloader        *reloader
+	lockTimeout     time.Duration
+	mergePolicy     MergePolicy
+	backups         int
+
+	dynamicCompletion      int  // the number of readings shown
+	dynamicCompletionShown bool // true while they are on the MiniBuffer
+
+	study    *study       // nil unless Config.Study
+	lastWord studyContext // the word confirmed last for study
+
+	dateAD      bool             // skk-date-ad
+	numberStyle int              // skk-number-style
+	now         func() time.Time // nil for time.Now
+}
+
+// dictionary is a source of candidates consulted after the user dictionary.
+type dictionary interface {
+	lookup(key string, okuri bool) ([]candidateT, bool)
 }
 
 func hanToZenString(s string) string {
@@ -86,73 +94,111 @@ func hanToZenString(s string) string {
 	return buffer.String()
 }
 
-func (M *Mode) _lookup(source string, okuri bool) ([]candidateT, bool) {
-	list, ok := M.User.lookup(source, okuri)
-	if ok {
-		return list, true
+// _lookup returns the candidates of the user dictionary in the learned order
+// followed by the ones of each system dictionary in the configured order
+// without duplicates.
+// When origins is not nil, the names of dictionaries where candidates
+// were found are stored into it with the key of candidate's Source().
+func (M *Mode) _lookup(source string, okuri bool, origins map[string]string) ([]candidateT, bool) {
+	list, _ := M.User.lookup(source, okuri)
+	list, ignored := splitIgnoredWords(list)
+	addOrigins(origins, M.userJisyoName(), list)
+	result := append([]candidateT{}, list...)
+	for _, L := range M.layers {
+		if list, ok := L.lookup(source, okuri); ok {
+			list = hideWords(list, ignored)
+			addOrigins(origins, L.name, list)
+			result = appendUnique(result, list)
+		}
 	}
-	list, ok = M.System.lookup(source, okuri)
-	return list, ok
+	if list, ok := M.System.lookup(source, okuri); ok {
+		list = hideWords(list, ignored)
+		addOrigins(origins, systemName, list)
+		result = appendUnique(result, list)
+	}
+	return result, len(result) > 0
 }
 
-func applyCandidateNumber(c candidateT, number string) candidateT {
-	source := c.String()
-	result := rxToNumber.ReplaceAllStringFunc(source, func(ss string) string {
-		switch ss[1] {
-		case '0': // 無変換
-			return number
-		case '1': // 全角化
-			return hanToZenString(number)
-		case '2': // 漢数字で位取りあり
-			return numberToKanji(number)
-		case '3': // 漢数字で位取りなし
-			return numberToKanji(number) // あとでやる
-		default:
-			return number
+// inSystemJisyo returns true when one of the system dictionaries has
+// the candidate for the reading.
+func (M *Mode) inSystemJisyo(source string, okuri bool, c candidateT) bool {
+	for _, L := range M.layers {
+		if list, ok := L.lookup(source, okuri); ok && containsCandidate(list, c) {
+			return true
 		}
-	})
-	if source == result {
-		return c
-	}
-	return &candidateFuncT{
-		source: c.Source(),
-		f: func() string {
-			return result
-		},
 	}
+	list, ok := M.System.lookup(source, okuri)
+	return ok && containsCandidate(list, c)
 }
 
-func (M *Mode) lookup(source string, okuri bool) ([]candidateT, bool) {
-	list, ok := M._lookup(source, okuri)
-	if ok {
-		return list, ok
-	}
-	loc := rxNumber.FindStringIndex(source)
-	if loc == nil {
-		return nil, false
-	}
-	number := source[loc[0]:loc[1]]
-	source = source[:loc[0]] + "#" + source[loc[1]:]
-	list, ok = M._lookup(source, okuri)
-	if !ok {
-		return nil, false
+// purgeable returns true when the user dictionary or the system
+// dictionaries have the candidate. The others like the results of
+// skk-calc can not be purged.
+func (M *Mode) purgeable(source string, okuri bool, c candidateT) bool {
+	return containsCandidate(M.userEntry(source, okuri), c) || M.inSystemJisyo(source, okuri, c)
+}
+
+// purge removes the candidate from the user dictionary. When the system
+// dictionaries have it, (skk-ignore-dic-word "...") is recorded in the
+// user dictionary to hide it like ddskk.
+func (M *Mode) purge(source string, okuri bool, c candidateT) {
+	userEntry := purgedList(M.userEntry(source, okuri), c)
+	if M.inSystemJisyo(source, okuri, c) {
+		userEntry = ignoreWord(userEntry, c.Source())
 	}
-	newList := make([]candidateT, 0, len(list))
-	for _, c := range list {
-		newList = append(newList, applyCandidateNumber(c, number))
+	if len(userEntry) <= 0 {
+		M.User.remove(source, okuri)
+	} else {
+		M.User.storeAndLearn(source, okuri, userEntry)
 	}
-	return newList, true
 }
 
-func unshift(list []candidateT, value candidateT) []candidateT {
-	var dummy candidateT
-	list = append(list, dummy)
-	copy(list[1:], list)
-	list[0] = value
+// userEntry returns the candidates of the user dictionary only.
+// Learning must be applied to them not to copy the system dictionaries
+// into the user dictionary.
+func (M *Mode) userEntry(source string, okuri bool) []candidateT {
+	list, _ := M.User.lookup(source, okuri)
 	return list
 }
 
-func (M *Mode) newCandidate(ctx context.Context, B *readline.Buffer, source string, okuri bool) (string, bool) {
+func (M *Mode) lookup(source string, okuri bool) ([]candidateT, bool) {
+	list, _, ok := M.lookupWithOrigins(source, okuri, nil)
+	return list, ok
+}
+
+// lookupWithOrigins returns the candidates of the reading and the key found
+// in the dictionaries. When the reading is not found and has numbers,
+// the key has "#" instead of them ("#がつ#にち") and the numbers are
+// converted into the candidates ("#1月#1日"). The key is where to learn.
+// The Lisp candidates are evaluated with skk-henkan-key and skk-num-list,
+// and the result of the arithmetic expression like "12*34" is appended.
+func (M *Mode) lookupWithOrigins(source string, okuri bool, origins map[string]string) ([]candidateT, string, bool) {
+	numberedKey, numbers := numberKey(source)
+	lc := M.lispContext(source, numbers)
+	key := source
+	list, ok := M._lookup(source, okuri, origins)
+	if ok {
+		bindLispContext(list, lc)
+	} else if len(numbers) > 0 {
+		if list, ok = M._lookup(numberedKey, okuri, origins); ok {
+			key = numberedKey
+			bindLispContext(list, lc)
+			newList := make([]candidateT, 0, len(list))
+			for _, c := range list {
+				newList = append(newList, M.applyCandidateNumbers(c, numbers)...)
+			}
+			list = newList
+		}
+	}
+	if !okuri {
+		list = appendCalcResult(list, source, lc, origins)
+	}
+	return list, key, len(list) > 0
+}
+
+// newCandidate registers the word asked on the prompt for `source` and
+// returns it to put at `markerPos`.
+func (M *Mode) newCandidate(ctx context.Context, B *readline.Buffer, markerPos int, source string, okuri bool, okurigana string) (string, bool) {
 	newWord, err := M.ask(ctx, B, source, true)
 	B.RepaintAfterPrompt()
 	if err != nil || len(newWord) <= 0 {
@@ -160,31 +206,90 @@ func (M *Mode) newCandidate(ctx context.Context, B *readline.Buffer, source stri
 	}
 	list, _ := M.lookup(source, okuri)
 
+	// 「単語;注釈」の形式で注釈つきで登録できる
+	word, _ := splitAnnotation(newWord)
+
 	// 二重登録よけ
 	for _, candidate := range list {
-		if candidate.String() == newWord {
-			return newWord, true
+		if w, _ := splitAnnotation(candidate.String()); w == word {
+			M.studyResult(B, markerPos, source, okuri, candidate)
+			return word, true
 		}
 	}
 	// リストの先頭に挿入
-	M.User.storeAndLearn(source, okuri, unshift(list, candidateStringT(newWord)))
-	return newWord, true
+	M.learn(source, okuri, candidateStringT(newWord), okurigana)
+	M.studyResult(B, markerPos, source, okuri, candidateStringT(newWord))
+	return word, true
 }
 
-const listingStartIndex = 4
+// learn moves the candidate `c` chosen for `key` to the head of
+// the entry of the user dictionary.
+func (M *Mode) learn(key string, okuri bool, c candidateT, okurigana string) {
+	if isCalcResult(c) {
+		return
+	}
+	M.User.storeAndLearn(key, okuri, learnedList(M.userEntry(key, okuri), c, okurigana))
+}
 
-func moveTop(list []candidateT, current int) {
-	newTop := list[current]
-	copy(list[1:current+1], list[:current])
-	list[0] = newTop
+// splitAnnotation splits the candidate "単語;注釈" into the word and the annotation
+func splitAnnotation(s string) (word, annotation string) {
+	word, annotation, _ = strings.Cut(s, ";")
+	return
+}
+
+// showAnnotation displays the annotation of the candidate on the MiniBuffer.
+// When the candidate has no annotation, it erases the last one.
+func (M *Mode) showAnnotation(B *readline.Buffer, annotation string, shown *bool) {
+	if !M.annotation {
+		return
+	}
+	if annotation != "" {
+		M.message(B, annotation)
+		*shown = true
+	} else if *shown {
+		M.hideAnnotation(B, shown)
+	}
+}
+
+func (M *Mode) hideAnnotation(B *readline.Buffer, shown *bool) {
+	if !*shown {
+		return
+	}
+	*shown = false
+	if M.kana != nil {
+		if _, ok := M.MiniBuffer.(MiniBufferOnNextLine); ok {
+			M.message(B, M.kana.modeStr)
+			return
+		}
+	}
+	M.message(B, "")
 }
 
+const listingStartIndex = 4
+
 func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int, source string, postfix string) readline.Result {
+	M.hideDynamicCompletion(B)
+	M.autoReload()
 	okuri := postfix != ""
-	list, found := M.lookup(source, okuri)
-	if !found {
+	okurigana := okuriganaOf(postfix)
+	origins := map[string]string{}
+	entry, key, found := M.lookupWithOrigins(source, okuri, origins)
+	list := selectOkuri(entry, okurigana)
+	if M.study != nil {
+		if prev := M.previousWord(B, markerPos); prev != "" {
+			list = M.study.reorder(prev, key, okuri, list)
+		}
+	}
+	if !found || len(list) <= 0 {
+		if _, _, err := calculate(source); errors.Is(err, errDivisionByZero) {
+			// 計算できない式は辞書登録せずに戻す
+			M.message(B, fmt.Sprintf("%s: %s", source, err.Error()))
+			B.ReplaceAndRepaint(markerPos, markerWhite+source)
+			replaceTriangle(B, markerPos, markerWhiteRune)
+			return readline.CONTINUE
+		}
 		// 辞書登録モード
-		result, ok := M.newCandidate(ctx, B, source, okuri)
+		result, ok := M.newCandidate(ctx, B, markerPos, source, okuri, okurigana)
 		if ok {
 			// 新変換文字列を展開する
 			B.ReplaceAndRepaint(markerPos, result)
@@ -196,10 +301,16 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 			return readline.CONTINUE
 		}
 	}
+	annotationShown := false
+	defer M.hideAnnotation(B, &annotationShown)
+	showCurrent := func(c candidateT) {
+		candidate, annotation := splitAnnotation(c.String())
+		B.ReplaceAndRepaint(markerPos, markerBlack+candidate+postfix)
+		replaceTriangle(B, markerPos, markerBlackRune)
+		M.showAnnotation(B, annotation, &annotationShown)
+	}
 	current := 0
-	candidate, _, _ := strings.Cut(list[current].String(), ";")
-	B.ReplaceAndRepaint(markerPos, markerBlack+candidate+postfix)
-	replaceTriangle(B, markerPos, markerBlackRune)
+	showCurrent(list[current])
 	for {
 		input, _ := B.GetKey()
 		if input == string(keys.CtrlG) {
@@ -212,15 +323,15 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 			}
 			removeOne(B, markerPos)
 			if current > 0 {
-				moveTop(list, current)
-				M.User.storeAndLearn(source, okuri, list)
+				M.learn(key, okuri, list[current], okurigana)
 			}
+			M.studyResult(B, markerPos, key, okuri, list[current])
 			return readline.CONTINUE
 		} else if input == " " {
 			current++
 			if current >= len(list) {
 				// 辞書登録モード
-				result, ok := M.newCandidate(ctx, B, source, okuri)
+				result, ok := M.newCandidate(ctx, B, markerPos, source, okuri, okurigana)
 				if ok {
 					// 新変換文字列を展開する
 					B.ReplaceAndRepaint(markerPos, result)
@@ -241,22 +352,30 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 						if _current >= len(list) {
 							break
 						}
-						candidate, _, _ = strings.Cut(list[_current].String(), ";")
-						fmt.Fprintf(&buffer, "%c:%s ", key, candidate)
+						candidate, annotation := splitAnnotation(list[_current].String())
+						fmt.Fprintf(&buffer, "%c:%s", key, candidate)
+						if M.annotation && annotation != "" {
+							fmt.Fprintf(&buffer, ";%s", annotation)
+						}
+						if M.showOrigin {
+							fmt.Fprintf(&buffer, "(%s)", origins[list[_current].Source()])
+						}
+						buffer.WriteByte(' ')
 						_current++
 					}
 					fmt.Fprintf(&buffer, "[残り %d]", len(list)-_current)
-					key, err := M.ask1(B, buffer.String())
+					ans, err := M.ask1(B, buffer.String())
 					if err == nil {
-						if index := strings.Index("asdfjkl", key); index >= 0 && current+index < len(list) {
-							candidate, _, _ = strings.Cut(list[current+index].String(), ";")
+						if index := strings.Index("asdfjkl", ans); index >= 0 && current+index < len(list) {
+							candidate, _ := splitAnnotation(list[current+index].String())
 							B.ReplaceAndRepaint(markerPos, candidate)
+							M.studyResult(B, markerPos, key, okuri, list[current+index])
 							return readline.CONTINUE
-						} else if key == " " {
+						} else if ans == " " {
 							current = _current
 							if current >= len(list) {
 								// 辞書登録モード
-								result, ok := M.newCandidate(ctx, B, source, okuri)
+								result, ok := M.newCandidate(ctx, B, markerPos, source, okuri, okurigana)
 								if ok {
 									// 新変換文字列を展開する
 									B.ReplaceAndRepaint(markerPos, result)
@@ -268,7 +387,7 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 									return readline.CONTINUE
 								}
 							}
-						} else if key == "x" {
+						} else if ans == "x" {
 							current -= len("ASDFJKL")
 							if current < listingStartIndex {
 								if current < 0 {
@@ -276,7 +395,7 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 								}
 								break
 							}
-						} else if key == string(keys.CtrlG) {
+						} else if ans == string(keys.CtrlG) {
 							B.ReplaceAndRepaint(markerPos, markerWhite+source)
 							replaceTriangle(B, markerPos, markerWhiteRune)
 							return readline.CONTINUE
@@ -284,9 +403,7 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 					}
 				}
 			} else {
-				candidate, _, _ = strings.Cut(list[current].String(), ";")
-				B.ReplaceAndRepaint(markerPos, markerBlack+candidate+postfix)
-				replaceTriangle(B, markerPos, markerBlackRune)
+				showCurrent(list[current])
 			}
 		} else if input == "x" {
 			current--
@@ -295,25 +412,22 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 				replaceTriangle(B, markerPos, markerWhiteRune)
 				return readline.CONTINUE
 			}
-			candidate, _, _ = strings.Cut(list[current].String(), ";")
-			B.ReplaceAndRepaint(markerPos, markerBlack+candidate+postfix)
-			replaceTriangle(B, markerPos, markerBlackRune)
+			showCurrent(list[current])
 		} else if input == "X" {
-			prompt := fmt.Sprintf(`really purge "%s /%s/ "?(yes or no)`, source, list[current].Source())
+			origin := origins[list[current].Source()]
+			if !M.purgeable(key, okuri, list[current]) {
+				M.message(B, fmt.Sprintf(`"%s /%s/" is in %s and can not be purged`,
+					key, list[current].Source(), origin))
+				continue
+			}
+			prompt := fmt.Sprintf(`really purge "%s /%s/ " from %s?(yes or no)`,
+				key, list[current].Source(), origin)
 			ans, err := M.ask(ctx, B, prompt, false)
 			if err == nil {
 				if ans == "y" || ans == "yes" {
-					// 本当はシステム辞書を参照しないようLisp構文を
-					// セットしなければいけないが、そこまではしない.
-					if len(list) <= 1 {
-						M.User.remove(source, okuri)
-					} else {
-						if current+1 < len(list) {
-							copy(list[current:], list[current+1:])
-						}
-						list = list[:len(list)-1]
-						M.User.storeAndLearn(source, okuri, list)
-					}
+					// システム辞書の候補はユーザ辞書に
+					// (skk-ignore-dic-word "...") を記録して隠す
+					M.purge(key, okuri, list[current])
 					B.ReplaceAndRepaint(markerPos, "")
 					return readline.CONTINUE
 				}
@@ -324,9 +438,9 @@ func (M *Mode) henkanMode(ctx context.Context, B *readline.Buffer, markerPos int
 			}
 			removeOne(B, markerPos)
 			if current > 0 {
-				moveTop(list, current)
-				M.User.storeAndLearn(source, okuri, list)
+				M.learn(key, okuri, list[current], okurigana)
 			}
+			M.studyResult(B, markerPos, key, okuri, list[current])
 			return eval(ctx, B, input)
 		}
 	}
@@ -362,7 +476,7 @@ func (trig *_Trigger) Call(ctx context.Context, B *readline.Buffer) readline.Res
 		return trig.M.henkanMode(ctx, B, markerPos, source.String(), postfix)
 	}
 	insertTriangleAndRepaint(B, markerWhiteRune)
-	r := &_Romaji{kana: trig.M.kana, last: string(trig.Key)}
+	r := &_Romaji{kana: trig.M.kana, last: string(trig.Key), M: trig.M}
 	return r.Call(ctx, B)
 }
 
@@ -403,6 +517,7 @@ func (M *Mode) cmdKakutei(ctx context.Context, B *readline.Buffer) readline.Resu
 		return M.cmdLatinMode(ctx, B)
 	}
 	// kakutei
+	M.hideDynamicCompletion(B)
 	removeOne(B, markerPos)
 	return readline.CONTINUE
 }
@@ -412,6 +527,7 @@ func (M *Mode) cmdCancel(ctx context.Context, B *readline.Buffer) readline.Resul
 	if markerPos < 0 {
 		return M.cmdLatinMode(ctx, B)
 	}
+	M.hideDynamicCompletion(B)
 	B.ReplaceAndRepaint(markerPos, "")
 	return readline.CONTINUE
 }
@@ -458,6 +574,7 @@ func (M *Mode) cmdAbbrevMode(ctx context.Context, B *readline.Buffer) readline.R
 			return rc
 		},
 	})
+	B.BindKey(keys.CtrlI, &readline.GoCommand{Name: "SKK_ABBREV_COMPLETE", Func: M.cmdComplete})
 	M.displayMode(B, msgAbbrev)
 	return readline.CONTINUE
 }
@@ -505,7 +622,7 @@ func (mode *Mode) enable(X canKeyMap, K *_Kana) {
 	mode.kana = K
 	for i := range romajiTrigger {
 		c := romajiTrigger[i : i+1]
-		X.BindKey(keys.Code(c), &_Romaji{kana: K, last: c})
+		X.BindKey(keys.Code(c), &_Romaji{kana: K, last: c, M: mode})
 	}
 	const upperRomaji = "AIUEOKSTNHMYRWFGZDBPCJ"
 	for i, c := range upperRomaji {
@@ -518,6 +635,7 @@ func (mode *Mode) enable(X canKeyMap, K *_Kana) {
 	X.BindKey("\x11", &readline.GoCommand{Name: "SKK_TOGGLE_HANKANA", Func: mode.cmdToggleHanKana})
 	X.BindKey("/", &readline.GoCommand{Name: "SKK_ABBREV_MODE", Func: mode.cmdAbbrevMode})
 	X.BindKey(" ", &readline.GoCommand{Name: "SKK_START_HENKAN", Func: mode.cmdStartHenkan})
+	X.BindKey(keys.CtrlI, &readline.GoCommand{Name: "SKK_COMPLETE", Func: mode.cmdComplete})
 	X.BindKey("l", &readline.GoCommand{Name: "SKK_LATIN_MODE", Func: mode.cmdLatinMode})
 	X.BindKey("L", &readline.GoCommand{Name: "SKK_JISX0208_LATIN_MODE", Func: mode.cmdJis0208LatinMode})
 	X.BindKey(keys.CtrlG, &readline.GoCommand{Name: "SKK_CANCEL", Func: mode.cmdCancel})
@@ -552,6 +670,8 @@ func (M *Mode) cmdLatinMode(ctx context.Context, B *readline.Buffer) readline.Re
 }
 
 func (M *Mode) cmdAcceptLineWithLatinMode(ctx context.Context, B *readline.Buffer) readline.Result {
+	M.autoReload()
+	M.lastWord = studyContext{}
 	if M.saveMap != nil {
 		M.restoreKeyMap(B)
 		M.displayMode(B, msgLatin)
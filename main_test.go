@@ -1,7 +1,13 @@
 package skk
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/nyaosorg/go-readline-ny"
+	"github.com/nyaosorg/go-readline-ny/keys"
 )
 
 func TestHanToZen(t *testing.T) {
@@ -28,3 +34,120 @@ func TestHanToZen(t *testing.T) {
 		}
 	}
 }
+
+type dummyKeyMap struct{}
+
+func (dummyKeyMap) BindKey(keys.Code, readline.Command) {}
+
+func TestAnnotation(t *testing.T) {
+	list := parseCandidates(`/漢字;かんじ/(concat "a\057b");スラッシュ/幹事/`, nil)
+	expect := []struct{ word, annotation string }{
+		{"漢字", "かんじ"},
+		{"a/b", "スラッシュ"},
+		{"幹事", ""},
+	}
+	if len(list) != len(expect) {
+		t.Fatalf("expect %d candidates, but %d", len(expect), len(list))
+	}
+	for i, e := range expect {
+		word, annotation := splitAnnotation(list[i].String())
+		if word != e.word || annotation != e.annotation {
+			t.Fatalf("expect %q and %q, but %q and %q", e.word, e.annotation, word, annotation)
+		}
+	}
+	if source := list[1].Source(); source != `(concat "a\057b");スラッシュ` {
+		t.Fatalf("Source: %s", source)
+	}
+}
+
+func TestLookupUnion(t *testing.T) {
+	M, err := Config{BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M.User.store("かんじ", false, []candidateT{candidateStringT("感じ"), candidateStringT("幹事")})
+	M.System.store("かんじ", false, []candidateT{
+		candidateStringT("漢字"), candidateStringT("感じ"), candidateStringT("幹事"), candidateStringT("完治"),
+	})
+	join := func(list []candidateT) string {
+		var s []string
+		for _, c := range list {
+			s = append(s, c.String())
+		}
+		return strings.Join(s, ",")
+	}
+	list, ok := M.lookup("かんじ", false)
+	if result := join(list); !ok || result != "感じ,幹事,漢字,完治" {
+		t.Fatalf("lookup: %s", result)
+	}
+	M.User.storeAndLearn("かんじ", false, learnedList(M.userEntry("かんじ", false), list[3], ""))
+	if result := join(M.userEntry("かんじ", false)); result != "完治,感じ,幹事" {
+		t.Fatalf("user: %s", result)
+	}
+	if result, _ := M.System.lookup("かんじ", false); join(result) != "漢字,感じ,幹事,完治" {
+		t.Fatalf("system: %s", join(result))
+	}
+}
+
+func TestPurgeSystemCandidate(t *testing.T) {
+	dir := t.TempDir()
+	systemJisyo := filepath.Join(dir, "SKK-JISYO.S")
+	err := os.WriteFile(systemJisyo,
+		[]byte(";; -*- coding: utf-8 -*-\n"+nasiHeader+"\nかんじ /漢字/幹事/感じ/\n"), 0666)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	userJisyo := filepath.Join(dir, "user-jisyo")
+	M, err := Config{
+		UserJisyoPath:    userJisyo,
+		SystemJisyoPaths: []string{systemJisyo},
+		BindTo:           dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+	M.User.store("かんじ", false, []candidateT{candidateStringT("感じ")})
+	join := func(list []candidateT) string {
+		var s []string
+		for _, c := range list {
+			s = append(s, c.String())
+		}
+		return strings.Join(s, ",")
+	}
+
+	if M.purgeable("かんじ", false, candidateStringT("完治")) {
+		t.Fatal("完治: must not be purgeable")
+	}
+	// a candidate of the system dictionary only
+	M.purge("かんじ", false, candidateStringT("幹事"))
+	// a candidate of both of the user and the system dictionaries
+	M.purge("かんじ", false, candidateStringT("感じ"))
+	if list, _ := M.lookup("かんじ", false); join(list) != "漢字" {
+		t.Fatalf("lookup: %s", join(list))
+	}
+	if source := M.userEntry("かんじ", false)[0].Source(); source != `(skk-ignore-dic-word "幹事" "感じ")` {
+		t.Fatalf("user: %s", source)
+	}
+	M.purge("かんじ", false, candidateStringT("漢字"))
+	if list, ok := M.lookup("かんじ", false); ok {
+		t.Fatalf("lookup: %s", join(list))
+	}
+
+	// the purge is kept in the user dictionary file
+	if err := M.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	M2, err := Config{
+		UserJisyoPath:    userJisyo,
+		SystemJisyoPaths: []string{systemJisyo},
+		BindTo:           dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M2.Close()
+	if list, ok := M2.lookup("かんじ", false); ok {
+		t.Fatalf("lookup after reload: %s", join(list))
+	}
+}
@@ -0,0 +1,174 @@
+package skk
+
+// MergePolicy decides the order of the candidates for a reading
+// when both of the dictionaries merged changed them.
+type MergePolicy int
+
+const (
+	// MergeOurs keeps the order of ours and appends the candidates
+	// added only by theirs.
+	MergeOurs MergePolicy = iota
+	// MergeTheirs keeps the order of theirs and appends the candidates
+	// added only by ours.
+	MergeTheirs
+)
+
+// clone returns the copy of the dictionary without the history.
+// The lists of candidates are shared because they are never modified in place.
+func (j *Jisyo) clone() *Jisyo {
+	c := newJisyo()
+	for key, list := range j.ari {
+		c.ari[key] = list
+	}
+	for key, list := range j.nasi {
+		c.nasi[key] = list
+	}
+	for key, n := range j.ariOrder {
+		c.ariOrder[key] = n
+	}
+	for key, n := range j.nasiOrder {
+		c.nasiOrder[key] = n
+	}
+	c.last = j.last
+	c.first = j.first
+	c.header = append([]string{}, j.header...)
+	return c
+}
+
+func sameList(a, b []candidateT) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !sameCandidate(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeCandidates merges the candidates without okuri blocks.
+// A candidate is kept when both sides have it or one side added it,
+// and it is dropped when one side removed it.
+func mergeCandidates(base, ours, theirs []candidateT, policy MergePolicy) []candidateT {
+	if sameList(ours, base) {
+		return theirs
+	}
+	if sameList(theirs, base) || sameList(ours, theirs) {
+		return ours
+	}
+	first, second := ours, theirs
+	if policy == MergeTheirs {
+		first, second = theirs, ours
+	}
+	var result []candidateT
+	for _, list := range [][]candidateT{first, second} {
+		for _, c := range list {
+			if containsCandidate(result, c) {
+				continue
+			}
+			if !containsCandidate(base, c) || (containsCandidate(ours, c) && containsCandidate(theirs, c)) {
+				result = append(result, c)
+			}
+		}
+	}
+	return result
+}
+
+func findBlock(blocks []*candidateBlockT, okuri string) []candidateT {
+	for _, b := range blocks {
+		if b.okuri == okuri {
+			return b.list
+		}
+	}
+	return nil
+}
+
+// mergeEntry merges the entries of a reading. The okuri blocks are
+// merged per okurigana and dropped when they become empty.
+func mergeEntry(base, ours, theirs []candidateT, policy MergePolicy) []candidateT {
+	if sameList(ours, base) {
+		return theirs
+	}
+	if sameList(theirs, base) || sameList(ours, theirs) {
+		return ours
+	}
+	basePlain, baseBlocks := splitBlocks(base)
+	oursPlain, oursBlocks := splitBlocks(ours)
+	theirsPlain, theirsBlocks := splitBlocks(theirs)
+
+	result := mergeCandidates(basePlain, oursPlain, theirsPlain, policy)
+
+	first, second := oursBlocks, theirsBlocks
+	if policy == MergeTheirs {
+		first, second = theirsBlocks, oursBlocks
+	}
+	done := map[string]bool{}
+	for _, blocks := range [][]*candidateBlockT{first, second} {
+		for _, b := range blocks {
+			if done[b.okuri] {
+				continue
+			}
+			done[b.okuri] = true
+			list := mergeCandidates(
+				findBlock(baseBlocks, b.okuri),
+				findBlock(oursBlocks, b.okuri),
+				findBlock(theirsBlocks, b.okuri),
+				policy)
+			if len(list) > 0 {
+				result = append(result, &candidateBlockT{okuri: b.okuri, list: list})
+			}
+		}
+	}
+	return result
+}
+
+func (j *Jisyo) history(okuri bool) []_History {
+	if okuri {
+		return j.ariHistory
+	}
+	return j.nasiHistory
+}
+
+// MergeJisyo merges the changes from `base` to `ours` and the ones
+// from `base` to `theirs` per candidate, and returns the new dictionary.
+// `base` is the common ancestor, for example the user dictionary when
+// it was loaded, and it can be nil when there is no ancestor.
+// The candidates added by either side are kept and the ones removed by
+// either side are dropped. When both sides changed the same reading,
+// `policy` decides the order of the candidates.
+// The readings keep the order of `theirs`, and the ones learned
+// in `ours` (with Store, Add and so on) move to the top.
+// None of the arguments are modified.
+func MergeJisyo(base, ours, theirs *Jisyo, policy MergePolicy) *Jisyo {
+	if base == nil {
+		base = newJisyo()
+	}
+	result := newJisyo()
+	result.header = append([]string{}, theirs.header...)
+	if len(result.header) <= 0 {
+		result.header = append([]string{}, ours.header...)
+	}
+	for _, okuri := range []bool{true, false} {
+		keys := theirs.sortedKeys(okuri)
+		for _, key := range ours.sortedKeys(okuri) {
+			if _, ok := theirs.lookup(key, okuri); !ok {
+				keys = append(keys, key)
+			}
+		}
+		for _, key := range keys {
+			b, _ := base.lookup(key, okuri)
+			o, _ := ours.lookup(key, okuri)
+			t, _ := theirs.lookup(key, okuri)
+			if list := mergeEntry(b, o, t, policy); len(list) > 0 {
+				result.store(key, okuri, list)
+			}
+		}
+		for _, h := range ours.history(okuri) {
+			if _, ok := result.lookup(h.key, okuri); ok && h.val != nil {
+				result.moveToTop(h.key, okuri)
+			}
+		}
+	}
+	return result
+}
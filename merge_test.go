@@ -0,0 +1,103 @@
+package skk
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func readJisyoString(t *testing.T, source string) *Jisyo {
+	t.Helper()
+	j := newJisyo()
+	if err := j.Read(strings.NewReader(";; -*- coding: utf-8 -*-\n" + source)); err != nil {
+		t.Fatal(err.Error())
+	}
+	return j
+}
+
+func TestMergeJisyo(t *testing.T) {
+	base := readJisyoString(t, ariHeader+"\n"+
+		"おくr /送/[る/送/]/\n"+
+		nasiHeader+"\n"+
+		"かんじ /漢字/幹事/\n"+
+		"あい /愛/\n"+
+		"うえ /上/\n")
+
+	ours := base.clone()
+	ours.Add("かんじ", false, NewCandidate("感じ"))
+	ours.Remove("あい", false)
+	ours.RemoveCandidate("かんじ", false, NewCandidate("幹事"))
+	ours.Store("おくr", true, learnedList(base.ari["おくr"], NewCandidate("贈"), "る"))
+
+	theirs := base.clone()
+	theirs.Add("かんじ", false, NewCandidate("監事"))
+	theirs.Add("した", false, NewCandidate("下"))
+	theirs.Store("おくr", true, learnedList(base.ari["おくr"], NewCandidate("遅"), "れ"))
+
+	expect := map[MergePolicy]string{
+		MergeOurs: ariHeader + "\n" +
+			"おくr /贈/送/遅/[る/贈/送/]/[れ/遅/]/\n" +
+			"\n" + nasiHeader + "\n" +
+			"かんじ /感じ/漢字/監事/\n" +
+			"した /下/\n" +
+			"うえ /上/\n",
+		MergeTheirs: ariHeader + "\n" +
+			"おくr /遅/送/贈/[れ/遅/]/[る/贈/送/]/\n" +
+			"\n" + nasiHeader + "\n" +
+			"かんじ /監事/漢字/感じ/\n" +
+			"した /下/\n" +
+			"うえ /上/\n",
+	}
+	for policy, e := range expect {
+		var buffer strings.Builder
+		if _, err := MergeJisyo(base, ours, theirs, policy).writeTo(&buffer); err != nil {
+			t.Fatal(err.Error())
+		}
+		if result := buffer.String(); result != e {
+			t.Fatalf("policy %d: expect\n%s\nbut\n%s", policy, e, result)
+		}
+	}
+}
+
+func TestSaveUserJisyoMerge(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	err := os.WriteFile(userPath, []byte(";; -*- coding: utf-8 -*-\n"+nasiHeader+"\nかんじ /漢字/\n"), 0666)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(userPath, past, past); err != nil {
+		t.Fatal(err.Error())
+	}
+	var modes [2]*Mode
+	for i := range modes {
+		modes[i], err = Config{UserJisyoPath: userPath, BindTo: dummyKeyMap{}}.Setup()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	modes[0].User.Add("かんじ", false, NewCandidate("感じ"))
+	modes[1].User.Add("かんじ", false, NewCandidate("幹事"))
+	modes[1].User.RemoveCandidate("かんじ", false, NewCandidate("漢字"))
+	for _, M := range modes {
+		if err := M.SaveUserJisyo(); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	result := newJisyo()
+	if err := result.Load(userPath); err != nil {
+		t.Fatal(err.Error())
+	}
+	list, _ := result.Lookup("かんじ", false)
+	if s := dumpString(list); s != "/幹事/感じ/" {
+		t.Fatalf("expect /幹事/感じ/, but %s", s)
+	}
+}
+
+func dumpString(list []candidateT) string {
+	var buffer strings.Builder
+	dumpCandidates(list, &buffer)
+	return buffer.String()
+}
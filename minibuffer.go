@@ -89,6 +89,9 @@ func (M *Mode) ask(ctx context.Context, B *readline.Buffer, prompt string, ime b
 		System:     M.System,
 		MiniBuffer: M.MiniBuffer.Recurse(),
 		ctrlJ:      M.ctrlJ,
+		layers:     M.layers,
+		annotation: M.annotation,
+		showOrigin: M.showOrigin,
 	}
 	if ime {
 		m.enable(inputNewWord, hiragana)
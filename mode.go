@@ -2,9 +2,13 @@ package skk
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/nyaosorg/go-readline-ny"
 	"github.com/nyaosorg/go-readline-ny/keys"
@@ -17,13 +21,119 @@ type Config struct {
 	BindTo           CanBindKey
 	KeepModeOnExit   bool
 	MiniBuffer       MiniBuffer
+
+	// SkkServAddrs are the addresses("host:port") of skkserv servers
+	// consulted after the user dictionary and before the system dictionaries.
+	// When the port is omitted, 1178 is used.
+	// The next address is used when the current server does not respond.
+	SkkServAddrs []string
+	// SkkServTimeout is the timeout to connect and to wait a reply (default: 3s)
+	SkkServTimeout time.Duration
+	// SkkServUTF8 is true when the servers speak UTF-8 instead of EUC-JP
+	SkkServUTF8 bool
+
+	// LazySystemJisyo is true to keep the system dictionaries as file images
+	// and to parse the candidates of a key only when it is looked up
+	// with the binary search instead of loading all entries at Setup.
+	// The files are still read and scanned once at Setup to index the lines.
+	LazySystemJisyo bool
+
+	// ShowAnnotation is true to display the annotation of candidates
+	// ("漢字;annotation") on the MiniBuffer in ▼ mode and in the listing
+	ShowAnnotation bool
+
+	// SystemJisyoPriority is the names of system dictionaries
+	// (the base names of the files or "skkserv:" + the first address of SkkServAddrs)
+	// consulted first in this order. The others follow in the order of
+	// SkkServAddrs and SystemJisyoPaths.
+	SystemJisyoPriority []string
+
+	// ShowOrigin is true to display the name of the dictionary
+	// where each candidate was found in the listing
+	ShowOrigin bool
+
+	// AutoReload is the interval to check the modification times of
+	// the dictionary files. When it is not zero, the changed system
+	// dictionaries are reloaded and the changed user dictionary is merged
+	// on a goroutine, and they are applied when the conversion starts
+	// or the line is accepted.
+	AutoReload time.Duration
+
+	// SystemJisyoCodings overrides the encodings of the system dictionaries
+	// detected with the BOM, the pragma (`-*- coding: utf-8 -*-`) or
+	// the byte sequences. The keys are the paths in SystemJisyoPaths or
+	// the base names of the files, and the values are the names of
+	// the encodings like "utf-8", "euc-jp", "euc-jis-2004" and "shift_jis".
+	SystemJisyoCodings map[string]string
+
+	// DynamicCompletion is the number of the readings completing the one
+	// after ▽ shown on the MiniBuffer while typing like dcomp of ddskk.
+	// Tab accepts the first one. They are looked up with the index
+	// of the user and system dictionaries except skkserv. 0 disables it.
+	DynamicCompletion int
+
+	// Study is true to remember which candidate was chosen after the word
+	// converted previously in the same line, and to show it first when
+	// the same reading is converted after the word again like skk-study
+	// of ddskk. The data is saved as UserJisyoPath+".study" with the user
+	// dictionary.
+	Study bool
+
+	// UserJisyoCoding is the name of the encoding to save the user dictionary
+	// like "euc-jp" or "euc-jis-2004" to share it with the older SKK
+	// implementations (default: "utf-8"). It is written in the pragma.
+	// The words which the encoding can not represent are saved as
+	// `(concat "\uXXXX")`, and the readings make SaveUserJisyo fail
+	// with ErrUnrepresentable. "euc-jis-2004" writes only JIS X 0208
+	// since JIS X 0212 and the vendor extensions of EUC-JP mean other
+	// characters in it. When it is set, the user dictionary is also read
+	// with it instead of detecting.
+	UserJisyoCoding string
+
+	// UserJisyoMergePolicy decides the order of candidates when the same
+	// reading was changed in this process and by another process
+	// while merging the user dictionary (default: MergeOurs)
+	UserJisyoMergePolicy MergePolicy
+
+	// UserJisyoBackups is the number of generations of the backups
+	// of the user dictionary kept by Mode.SaveUserJisyo (default: 1).
+	// The newest one is UserJisyoPath+".BAK" and the older ones are
+	// UserJisyoPath+".BAK.1", ".BAK.2" and so on.
+	UserJisyoBackups int
+
+	// UserJisyoLockTimeout is the time to wait for another process
+	// to finish saving the user dictionary (default: 5s)
+	UserJisyoLockTimeout time.Duration
+
+	// DateAD is true to write the year in the Christian era instead of
+	// the Japanese era (令和, 平成...) with the date functions of Lisp
+	// candidates like skk-current-date (skk-date-ad of ddskk)
+	DateAD bool
+
+	// NumberStyle is the numeric conversion type (0: "2026", 1: "２０２６",
+	// 2: "二〇二六", 3: "二千二十六", 5: "弐阡弐拾六"...) of the numbers
+	// written by the date functions (skk-number-style of ddskk)
+	NumberStyle int
+
+	// Now is the clock of the date functions of Lisp candidates.
+	// time.Now is used when it is nil.
+	Now func() time.Time
 }
 
 func (c Config) Setup() (skkMode *Mode, err error) {
 	skkMode = &Mode{
-		User:       newJisyo(),
-		System:     newJisyo(),
-		MiniBuffer: MiniBufferOnNextLine{},
+		User:              newJisyo(),
+		System:            newJisyo(),
+		MiniBuffer:        MiniBufferOnNextLine{},
+		annotation:        c.ShowAnnotation,
+		showOrigin:        c.ShowOrigin,
+		lockTimeout:       c.UserJisyoLockTimeout,
+		mergePolicy:       c.UserJisyoMergePolicy,
+		backups:           c.UserJisyoBackups,
+		dynamicCompletion: c.DynamicCompletion,
+		dateAD:            c.DateAD,
+		numberStyle:       c.NumberStyle,
+		now:               c.Now,
 	}
 	if c.MiniBuffer != nil {
 		skkMode.MiniBuffer = c.MiniBuffer
@@ -33,18 +143,58 @@ func (c Config) Setup() (skkMode *Mode, err error) {
 	} else {
 		skkMode.ctrlJ = keys.CtrlJ
 	}
+	if c.UserJisyoCoding != "" {
+		if _, ok := codingSystem(c.UserJisyoCoding); !ok {
+			return nil, fmt.Errorf("%s: unknown coding", c.UserJisyoCoding)
+		}
+		skkMode.userJisyoCoding = c.UserJisyoCoding
+	}
 	if c.UserJisyoPath != "" {
 		var err error
-		skkMode.userJisyoStamp, err = skkMode.User.load(expandEnv(c.UserJisyoPath))
+		skkMode.userJisyoStamp, err = skkMode.User.load(expandEnv(c.UserJisyoPath), c.UserJisyoCoding)
 		if err != nil && !os.IsNotExist(err) {
 			return nil, err
 		}
 		skkMode.userJisyoPath = c.UserJisyoPath
+		skkMode.userBase = skkMode.User.clone()
+	}
+	if c.Study {
+		skkMode.study = newStudy()
+		if c.UserJisyoPath != "" {
+			err := skkMode.study.load(studyPath(expandEnv(c.UserJisyoPath)))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+	if len(c.SkkServAddrs) > 0 {
+		skkMode.layers = append(skkMode.layers, &jisyoLayer{
+			name:       "skkserv:" + c.SkkServAddrs[0],
+			dictionary: newSkkServ(c.SkkServAddrs, c.SkkServTimeout, c.SkkServUTF8),
+		})
+	}
+	for name, coding := range c.SystemJisyoCodings {
+		if _, ok := codingSystem(coding); !ok {
+			skkMode.Close()
+			return nil, fmt.Errorf("%s: unknown coding: %s", name, coding)
+		}
 	}
 	for _, fn := range c.SystemJisyoPaths {
-		err = skkMode.System.Load(fn)
-		if err != nil {
-			return nil, err
+		for _, fn1 := range expandJisyoPath(fn) {
+			L, err := openSystemJisyo(fn1, c.LazySystemJisyo, c.systemJisyoCoding(fn, fn1))
+			if err != nil {
+				skkMode.Close()
+				return nil, err
+			}
+			skkMode.layers = append(skkMode.layers, L)
+		}
+	}
+	skkMode.layers = sortLayers(skkMode.layers, c.SystemJisyoPriority)
+	if c.AutoReload > 0 {
+		skkMode.reloader = &reloader{
+			interval:  c.AutoReload,
+			lastCheck: time.Now(),
+			layers:    map[string]*jisyoLayer{},
 		}
 	}
 	if c.BindTo == nil {
@@ -57,6 +207,17 @@ func (c Config) Setup() (skkMode *Mode, err error) {
 	return skkMode, nil
 }
 
+// systemJisyoCoding returns the name of the encoding configured for
+// the file `filename` expanded from `path` in SystemJisyoPaths.
+func (c Config) systemJisyoCoding(path, filename string) string {
+	for _, key := range []string{path, filename, filepath.Base(filename)} {
+		if coding, ok := c.SystemJisyoCodings[key]; ok {
+			return coding
+		}
+	}
+	return ""
+}
+
 func (c Config) SetupWithString(env string) (*Mode, error) {
 	for {
 		var env1 string
@@ -100,6 +261,7 @@ func (M *Mode) String() string {
 
 // Call is readline.Command to start SKK henkan mode.
 func (M *Mode) Call(ctx context.Context, B *readline.Buffer) readline.Result {
+	M.autoReload()
 	M.enable(B, hiragana)
 	M.displayMode(B, msgHiragana)
 	return readline.CONTINUE
@@ -108,50 +270,108 @@ func (M *Mode) Call(ctx context.Context, B *readline.Buffer) readline.Result {
 // SaveUserJisyo saves the user dictionary as filename.
 // The file is first created with the name filename+".TMP",
 // and replaced with the file of filename after closing.
-// The original file is renamed to filename + ".BAK".
+// The original file is renamed to filename + ".BAK", and the older
+// backups to filename + ".BAK.1", ".BAK.2" ... up to Config.UserJisyoBackups
+// generations.
+// When another process updated the file after it was loaded,
+// the changes of both are merged with MergeJisyo.
+// The data of Config.Study is saved as filename+".study".
+// While saving, the lock file filename+".LOCK" is locked
+// not to lose the changes of other processes saving at the same time.
 func (M *Mode) SaveUserJisyo() error {
 	if M.userJisyoPath == "" {
 		return nil
 	}
 	filename := expandEnv(M.userJisyoPath)
 
-	stat, err := os.Stat(filename)
-	if os.IsNotExist(err) {
-		return M.User.saveAs(filename)
+	unlock, err := lockFile(filename+".LOCK", M.lockTimeout)
+	if err != nil {
+		return err
 	}
-	tmpName := filename + ".TMP"
+	defer unlock()
 
+	stat, err := os.Stat(filename)
 	if err == nil && stat.ModTime() != M.userJisyoStamp {
 		// merge
 		other := newJisyo()
-		if err = other.Load(filename); err != nil {
+		if _, err = other.load(filename, M.userJisyoCoding); err != nil {
 			return fmt.Errorf("fail to merge: %w", err)
 		}
-		for _, h := range M.User.ariHistory {
-			if h.val == nil {
-				delete(other.ari, h.key)
-			} else {
-				other.ari[h.key] = h.val
-			}
-		}
-		for _, h := range M.User.nasiHistory {
-			if h.val == nil {
-				delete(other.nasi, h.key)
-			} else {
-				other.nasi[h.key] = h.val
-			}
-		}
-		M.User = other
+		M.mergeUserJisyo(other)
 	}
-	if err := M.User.saveAs(tmpName); err != nil {
+	if err := M.replaceUserJisyo(filename); err != nil {
 		return err
 	}
-	backup := filename + ".BAK"
-	if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+	return M.saveStudy(filename)
+}
+
+// saveStudy saves the data of Config.Study next to the user dictionary
+func (M *Mode) saveStudy(filename string) error {
+	if M.study == nil {
+		return nil
+	}
+	return M.study.save(studyPath(filename))
+}
+
+// replaceUserJisyo writes the user dictionary to filename+".TMP" with
+// Config.UserJisyoCoding and replaces filename with it after rotating
+// the backups.
+func (M *Mode) replaceUserJisyo(filename string) error {
+	tmpName := filename + ".TMP"
+	if err := M.User.saveAs(tmpName, M.userJisyoCoding); err != nil {
+		return err
+	}
+	if err := rotateBackups(filename, M.backups); err != nil {
 		return err
 	}
-	if err := os.Rename(filename, backup); err != nil && !os.IsNotExist(err) {
+	if err := os.Rename(tmpName, filename); err != nil {
 		return err
 	}
-	return os.Rename(tmpName, filename)
+	if stamp, err := modTime(filename); err == nil {
+		M.userJisyoStamp = stamp
+	}
+	M.snapshotUserJisyo()
+	return nil
+}
+
+// mergeUserJisyo replaces the user dictionary with the three-way merge of
+// `other` read from the file updated by another process and the changes
+// in this process since the file was loaded or saved last.
+func (M *Mode) mergeUserJisyo(other *Jisyo) {
+	merged := MergeJisyo(M.userBase, M.User, other, M.mergePolicy)
+	// keep the history to move the learned readings to the top
+	// again when merging until the user dictionary is saved.
+	merged.ariHistory = M.User.ariHistory
+	merged.nasiHistory = M.User.nasiHistory
+	M.User = merged
+	M.userBase = other
+}
+
+// snapshotUserJisyo records the user dictionary as the common ancestor
+// of the next merge.
+func (M *Mode) snapshotUserJisyo() {
+	M.userBase = M.User.clone()
+	M.User.ariHistory = nil
+	M.User.nasiHistory = nil
+}
+
+// Close disconnects from the skkserv servers and closes the files of
+// the dictionaries looked up directly (CDB).
+func (M *Mode) Close() error {
+	var errs []error
+	if r := M.reloader; r != nil {
+		r.wg.Wait()
+		for _, L := range r.layers {
+			closeLayer(L)
+		}
+		r.layers = map[string]*jisyoLayer{}
+	}
+	for _, L := range M.layers {
+		if c, ok := L.dictionary.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,209 @@
+package skk
+
+import (
+	"regexp"
+	"strings"
+)
+
+var rxNumber = regexp.MustCompile(`[0-9]+`)
+
+var rxToNumber = regexp.MustCompile(`#[0-9]`)
+
+var kansuji = map[rune]string{
+	'0': "〇",
+	'1': "一",
+	'2': "二",
+	'3': "三",
+	'4': "四",
+	'5': "五",
+	'6': "六",
+	'7': "七",
+	'8': "八",
+	'9': "九",
+}
+
+func numberToKanji(s string) string {
+	var buffer strings.Builder
+	for _, r := range s {
+		buffer.WriteString(kansuji[r])
+	}
+	return buffer.String()
+}
+
+// numerals is the way to write numbers with the units of the places
+type numerals struct {
+	digits  [10]string
+	small   [3]string // the places of 10, 100 and 1000
+	large   []string  // the places of 10^4, 10^8, 10^12...
+	omitOne bool      // "十" instead of "一十" before the small units
+}
+
+var positionalKanji = &numerals{
+	digits: [10]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"},
+	small:  [3]string{"十", "百", "千"},
+	large:  []string{"万", "億", "兆", "京", "垓"},
+
+	omitOne: true,
+}
+
+var daiji = &numerals{
+	digits: [10]string{"〇", "壱", "弐", "参", "四", "伍", "六", "七", "八", "九"},
+	small:  [3]string{"拾", "百", "阡"},
+	large:  []string{"萬", "億", "兆", "京", "垓"},
+}
+
+// format returns the number `s` written like "十二万三千".
+// The numbers too large for the units are written digit by digit.
+func (n *numerals) format(s string) string {
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return n.digits[0]
+	}
+	groups := (len(s) + 3) / 4
+	if groups-1 > len(n.large) {
+		return numberToKanji(s)
+	}
+	var buffer strings.Builder
+	for g := groups - 1; g >= 0; g-- {
+		end := len(s) - g*4
+		start := end - 4
+		if start < 0 {
+			start = 0
+		}
+		group := s[start:end]
+		written := false
+		for i, d := range group {
+			if d == '0' {
+				continue
+			}
+			written = true
+			place := len(group) - 1 - i
+			if place == 0 || d != '1' || !n.omitOne {
+				buffer.WriteString(n.digits[d-'0'])
+			}
+			if place > 0 {
+				buffer.WriteString(n.small[place-1])
+			}
+		}
+		if written && g > 0 {
+			buffer.WriteString(n.large[g-1])
+		}
+	}
+	return buffer.String()
+}
+
+// numberWithCommas returns the number with commas every three digits
+func numberWithCommas(s string) string {
+	var buffer strings.Builder
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			buffer.WriteByte(',')
+		}
+		buffer.WriteRune(r)
+	}
+	return buffer.String()
+}
+
+// numberToShogi returns the square of the shogi notation like "７六"
+// for "76". The numbers not of two digits are returned as they are.
+func numberToShogi(s string) string {
+	if len(s) != 2 || strings.ContainsRune(s, '0') {
+		return s
+	}
+	return hanToZenString(s[:1]) + kansuji[rune(s[1])]
+}
+
+// numberKey replaces the numbers in the reading with "#" and returns
+// the numbers in the order of appearance.
+func numberKey(source string) (string, []string) {
+	return rxNumber.ReplaceAllString(source, "#"), rxNumber.FindAllString(source, -1)
+}
+
+// lookupNumber returns the words of the number itself as a reading for #4.
+// It returns the number when the dictionaries do not have it.
+func (M *Mode) lookupNumber(number string) []string {
+	list, _ := M._lookup(number, false, nil)
+	var result []string
+	for _, c := range list {
+		if _, ok := c.(*candidateBlockT); ok {
+			continue
+		}
+		word, _ := splitAnnotation(c.String())
+		if !rxToNumber.MatchString(word) {
+			result = append(result, word)
+		}
+	}
+	if len(result) <= 0 {
+		return []string{number}
+	}
+	return result
+}
+
+// formatNumber writes the number with the numeric conversion type
+// except #4, which needs the dictionaries.
+func formatNumber(numberType byte, number string) string {
+	switch numberType {
+	case '0': // 無変換
+		return number
+	case '1': // 全角化
+		return hanToZenString(number)
+	case '2': // 漢数字で位取りなし
+		return numberToKanji(number)
+	case '3': // 漢数字で位取りあり
+		return positionalKanji.format(number)
+	case '5': // 大字
+		return daiji.format(number)
+	case '8': // 桁区切り
+		return numberWithCommas(number)
+	case '9': // 将棋の棋譜
+		return numberToShogi(number)
+	default:
+		return number
+	}
+}
+
+func (M *Mode) convertNumber(numberType byte, number string) []string {
+	if numberType == '4' { // 数値を見出し語として再検索
+		return M.lookupNumber(number)
+	}
+	return []string{formatNumber(numberType, number)}
+}
+
+// applyCandidateNumbers replaces "#0"..."#9" in the candidate with
+// the numbers of the reading in order. A candidate with "#4" becomes
+// as many candidates as the words of the number.
+func (M *Mode) applyCandidateNumbers(c candidateT, numbers []string) []candidateT {
+	source := c.String()
+	locs := rxToNumber.FindAllStringIndex(source, -1)
+	if locs == nil {
+		return []candidateT{c}
+	}
+	results := []string{""}
+	last := 0
+	for i, loc := range locs {
+		text := source[last:loc[0]]
+		converted := []string{source[loc[0]:loc[1]]}
+		if i < len(numbers) {
+			converted = M.convertNumber(source[loc[0]+1], numbers[i])
+		}
+		newResults := make([]string, 0, len(results)*len(converted))
+		for _, r := range results {
+			for _, s := range converted {
+				newResults = append(newResults, r+text+s)
+			}
+		}
+		results = newResults
+		last = loc[1]
+	}
+	list := make([]candidateT, 0, len(results))
+	for _, r := range results {
+		result := r + source[last:]
+		list = append(list, &candidateFuncT{
+			source: c.Source(),
+			f: func() string {
+				return result
+			},
+		})
+	}
+	return list
+}
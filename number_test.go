@@ -0,0 +1,76 @@
+package skk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNumerals(t *testing.T) {
+	tests := []struct {
+		n        *numerals
+		source   string
+		expected string
+	}{
+		{positionalKanji, "0", "〇"},
+		{positionalKanji, "10", "十"},
+		{positionalKanji, "1024", "千二十四"},
+		{positionalKanji, "123000", "十二万三千"},
+		{positionalKanji, "10000", "一万"},
+		{positionalKanji, "100000001", "一億一"},
+		{daiji, "1999", "壱阡九百九拾九"},
+		{daiji, "20000", "弐萬"},
+	}
+	for _, tt := range tests {
+		if result := tt.n.format(tt.source); result != tt.expected {
+			t.Errorf("format(%s): expect %s, but %s", tt.source, tt.expected, result)
+		}
+	}
+	if result := numberWithCommas("1234567"); result != "1,234,567" {
+		t.Errorf("numberWithCommas: %s", result)
+	}
+	if result := numberToShogi("76"); result != "７六" {
+		t.Errorf("numberToShogi: %s", result)
+	}
+}
+
+func TestLookupNumber(t *testing.T) {
+	M, err := Config{BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M.System.store("#がつ#にち", false, parseCandidates("/#0月#0日/#1月#1日/#3月#3日/", nil))
+	M.System.store("#えん", false, parseCandidates("/#5円/#8円/", nil))
+	M.System.store("#", false, parseCandidates("/#2/#9/", nil))
+	M.System.store("#ばん", false, parseCandidates("/#4番/", nil))
+	M.System.store("1", false, parseCandidates("/壱/①/", nil))
+
+	tests := map[string]string{
+		"12がつ25にち": "/12月25日/１２月２５日/十二月二十五日/",
+		"1999えん":   "/壱阡九百九拾九円/1,999円/",
+		"76":       "/七六/７六/",
+		"1ばん":      "/壱番/①番/",
+		"2ばん":      "/2番/",
+	}
+	join := func(list []candidateT) string {
+		var buffer strings.Builder
+		buffer.WriteByte('/')
+		for _, c := range list {
+			buffer.WriteString(c.String())
+			buffer.WriteByte('/')
+		}
+		return buffer.String()
+	}
+	for source, expected := range tests {
+		list, ok := M.lookup(source, false)
+		if !ok {
+			t.Fatalf("%s: not found", source)
+		}
+		if result := join(list); result != expected {
+			t.Errorf("%s: expect %s, but %s", source, expected, result)
+		}
+	}
+	_, key, _ := M.lookupWithOrigins("12がつ25にち", false, nil)
+	if key != "#がつ#にち" {
+		t.Errorf("key: %s", key)
+	}
+}
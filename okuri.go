@@ -0,0 +1,262 @@
+package skk
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// candidateBlockT is the block of okuri-ari entries like `[る/送/]`
+// which lists the candidates used with the okurigana.
+type candidateBlockT struct {
+	okuri string
+	list  []candidateT
+}
+
+func (c *candidateBlockT) Source() string {
+	var buffer strings.Builder
+	buffer.WriteByte('[')
+	buffer.WriteString(c.okuri)
+	buffer.WriteByte('/')
+	for _, c1 := range c.list {
+		buffer.WriteString(c1.Source())
+		buffer.WriteByte('/')
+	}
+	buffer.WriteByte(']')
+	return buffer.String()
+}
+
+func (c *candidateBlockT) String() string { return c.Source() }
+
+// okuriganaOf returns the okurigana used to select the block from the postfix of henkanMode
+func okuriganaOf(postfix string) string {
+	if postfix == "" || postfix[0] == '*' {
+		return ""
+	}
+	c, _ := utf8.DecodeRuneInString(postfix)
+	if 'ァ' <= c && c <= 'ヶ' {
+		c += 'ぁ' - 'ァ'
+	}
+	return string(c)
+}
+
+func isHiragana(s string) bool {
+	for _, c := range s {
+		if c < 'ぁ' || c > 'ゖ' {
+			return false
+		}
+	}
+	return true
+}
+
+func sameCandidate(a, b candidateT) bool {
+	return a.Source() == b.Source()
+}
+
+func removeCandidate(list []candidateT, c candidateT) []candidateT {
+	newList := make([]candidateT, 0, len(list))
+	for _, c1 := range list {
+		if !sameCandidate(c1, c) {
+			newList = append(newList, c1)
+		}
+	}
+	return newList
+}
+
+func containsCandidate(list []candidateT, c candidateT) bool {
+	for _, c1 := range list {
+		if sameCandidate(c1, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendUnique appends the candidates in `list` which `result` does not have.
+// The okuri blocks are always appended.
+func appendUnique(result, list []candidateT) []candidateT {
+	for _, c := range list {
+		if _, ok := c.(*candidateBlockT); ok || !containsCandidate(result, c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// splitBlocks separates the candidates and the okuri blocks
+func splitBlocks(list []candidateT) (plain []candidateT, blocks []*candidateBlockT) {
+	for _, c := range list {
+		if b, ok := c.(*candidateBlockT); ok {
+			blocks = append(blocks, b)
+		} else {
+			plain = append(plain, c)
+		}
+	}
+	return
+}
+
+// selectOkuri returns the candidates to display for the okurigana:
+// the ones in the block of okurigana first, the others without blocks next,
+// and the ones only in the blocks of other okurigana last.
+func selectOkuri(list []candidateT, okurigana string) []candidateT {
+	plain, blocks := splitBlocks(list)
+	if len(blocks) <= 0 {
+		return list
+	}
+	var result []candidateT
+	for _, b := range blocks {
+		if b.okuri == okurigana {
+			for _, c := range b.list {
+				if !containsCandidate(result, c) {
+					result = append(result, c)
+				}
+			}
+		}
+	}
+	for _, c := range plain {
+		if !containsCandidate(result, c) {
+			result = append(result, c)
+		}
+	}
+	for _, b := range blocks {
+		for _, c := range b.list {
+			if !containsCandidate(result, c) {
+				result = append(result, c)
+			}
+		}
+	}
+	return result
+}
+
+// learnedList returns the new list of the dictionary entry
+// where `chosen` moves to the top. When okurigana is not empty,
+// `chosen` also moves to the top of the block of okurigana,
+// which is created when it does not exist.
+func learnedList(list []candidateT, chosen candidateT, okurigana string) []candidateT {
+	plain, blocks := splitBlocks(list)
+	result := append([]candidateT{chosen}, removeCandidate(plain, chosen)...)
+	if okurigana == "" {
+		for _, b := range blocks {
+			result = append(result, b)
+		}
+		return result
+	}
+	found := false
+	var rest []candidateT
+	for _, b := range blocks {
+		if b.okuri == okurigana && !found {
+			found = true
+			result = append(result, &candidateBlockT{
+				okuri: okurigana,
+				list:  append([]candidateT{chosen}, removeCandidate(b.list, chosen)...),
+			})
+		} else {
+			rest = append(rest, b)
+		}
+	}
+	if !found {
+		result = append(result, &candidateBlockT{okuri: okurigana, list: []candidateT{chosen}})
+	}
+	return append(result, rest...)
+}
+
+const ignoreDicWord = "skk-ignore-dic-word"
+
+// ignoredWords returns the sources of the candidates hidden by
+// `(skk-ignore-dic-word "word"...)` in the user dictionary like ddskk.
+// ok is false when `c` is not such a form.
+func ignoredWords(c candidateT) (words []string, ok bool) {
+	source := c.Source()
+	if !strings.HasPrefix(source, "("+ignoreDicWord+" ") {
+		return nil, false
+	}
+	sxpr, err := parser1.Read(strings.NewReader(source))
+	if err != nil {
+		return nil, false
+	}
+	list, err := listToSlice(sxpr)
+	if err != nil || len(list) < 1 || list[0] != (symbol{value: ignoreDicWord}) {
+		return nil, false
+	}
+	for _, v := range list[1:] {
+		if s, ok := v.(string); ok {
+			words = append(words, s)
+		}
+	}
+	return words, true
+}
+
+// splitIgnoredWords separates the forms of skk-ignore-dic-word from
+// the candidates and returns the words hidden by them.
+func splitIgnoredWords(list []candidateT) ([]candidateT, map[string]bool) {
+	var ignored map[string]bool
+	result := list[:0:0]
+	for _, c := range list {
+		words, ok := ignoredWords(c)
+		if !ok {
+			result = append(result, c)
+			continue
+		}
+		if ignored == nil {
+			ignored = map[string]bool{}
+		}
+		for _, w := range words {
+			ignored[w] = true
+		}
+	}
+	return result, ignored
+}
+
+// hideWords returns the candidates without the ones in `words`
+// in both of the candidates and the blocks.
+func hideWords(list []candidateT, words map[string]bool) []candidateT {
+	if len(words) <= 0 {
+		return list
+	}
+	var result []candidateT
+	for _, c := range list {
+		if b, ok := c.(*candidateBlockT); ok {
+			if newList := hideWords(b.list, words); len(newList) > 0 {
+				result = append(result, &candidateBlockT{okuri: b.okuri, list: newList})
+			}
+		} else if !words[c.Source()] {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// ignoreWord returns the list of the user dictionary entry whose
+// skk-ignore-dic-word hides `word` too.
+func ignoreWord(list []candidateT, word string) []candidateT {
+	result := make([]candidateT, 0, len(list)+1)
+	words := []any{symbol{value: ignoreDicWord}}
+	for _, c := range list {
+		if ws, ok := ignoredWords(c); ok {
+			for _, w := range ws {
+				if w != word {
+					words = append(words, w)
+				}
+			}
+		} else {
+			result = append(result, c)
+		}
+	}
+	words = append(words, word)
+	return append(result, parseCandidate(lispPrin1(sliceToList(words))))
+}
+
+// purgedList returns the new list of the dictionary entry without `c`
+// in both of the candidates and the blocks.
+func purgedList(list []candidateT, c candidateT) []candidateT {
+	var result []candidateT
+	for _, c1 := range list {
+		if b, ok := c1.(*candidateBlockT); ok {
+			if newList := removeCandidate(b.list, c); len(newList) > 0 {
+				result = append(result, &candidateBlockT{okuri: b.okuri, list: newList})
+			}
+		} else if !sameCandidate(c1, c) {
+			result = append(result, c1)
+		}
+	}
+	return result
+}
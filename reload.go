@@ -0,0 +1,148 @@
+package skk
+
+import (
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// reloader checks the modification times of the dictionary files
+// on a goroutine and keeps the dictionaries reloaded there until
+// the Mode applies them between conversions.
+type reloader struct {
+	interval  time.Duration
+	lastCheck time.Time
+
+	mu      sync.Mutex
+	running bool
+	layers  map[string]*jisyoLayer // path -> reloaded layer
+	user    *Jisyo
+	stamp   time.Time
+	wg      sync.WaitGroup
+}
+
+type reloadTarget struct {
+	path   string
+	stamp  time.Time
+	lazy   bool
+	coding string
+}
+
+func modTime(filename string) (time.Time, error) {
+	stat, err := os.Stat(filename)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return stat.ModTime(), nil
+}
+
+// check reloads the files whose modification times differ from the stamps.
+// It runs on a goroutine and does not touch the Mode.
+func (r *reloader) check(targets []reloadTarget, userPath, userCoding string, userStamp time.Time) {
+	defer r.wg.Done()
+
+	layers := map[string]*jisyoLayer{}
+	for _, t := range targets {
+		stamp, err := modTime(t.path)
+		if err != nil || stamp.Equal(t.stamp) {
+			continue
+		}
+		if L, err := openSystemJisyo(t.path, t.lazy, t.coding); err == nil {
+			layers[t.path] = L
+		}
+	}
+	var user *Jisyo
+	var stamp time.Time
+	if userPath != "" {
+		if s, err := modTime(userPath); err == nil && !s.Equal(userStamp) {
+			j := newJisyo()
+			if s, err := j.load(userPath, userCoding); err == nil {
+				user = j
+				stamp = s
+			}
+		}
+	}
+
+	r.mu.Lock()
+	for path, L := range layers {
+		if old, ok := r.layers[path]; ok {
+			closeLayer(old)
+		}
+		r.layers[path] = L
+	}
+	if user != nil {
+		r.user = user
+		r.stamp = stamp
+	}
+	r.running = false
+	r.mu.Unlock()
+}
+
+func closeLayer(L *jisyoLayer) error {
+	if c, ok := L.dictionary.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// layerIndexOfPath returns the index of the layer loaded from `path`, or -1
+func (M *Mode) layerIndexOfPath(path string) int {
+	for i, L := range M.layers {
+		if L.path != "" && L.path == path {
+			return i
+		}
+	}
+	return -1
+}
+
+// autoReload applies the dictionaries reloaded since the last call
+// and starts checking the files again when the interval has passed.
+// It is called where no conversion is in progress.
+func (M *Mode) autoReload() {
+	r := M.reloader
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	layers := r.layers
+	user, stamp := r.user, r.stamp
+	r.layers = map[string]*jisyoLayer{}
+	r.user = nil
+	start := !r.running && time.Since(r.lastCheck) >= r.interval
+	if start {
+		r.running = true
+		r.lastCheck = time.Now()
+	}
+	r.mu.Unlock()
+
+	for path, newL := range layers {
+		if i := M.layerIndexOfPath(path); i >= 0 {
+			newL.name = M.layers[i].name
+			closeLayer(M.layers[i])
+			M.layers[i] = newL
+		} else {
+			// removed by RemoveSystemJisyo while reloading
+			closeLayer(newL)
+		}
+	}
+	if user != nil {
+		M.mergeUserJisyo(user)
+		M.userJisyoStamp = stamp
+	}
+	if !start {
+		return
+	}
+	targets := make([]reloadTarget, 0, len(M.layers))
+	for _, L := range M.layers {
+		if L.path != "" {
+			targets = append(targets, reloadTarget{path: L.path, stamp: L.stamp, lazy: L.lazy, coding: L.coding})
+		}
+	}
+	userPath := ""
+	if M.userJisyoPath != "" {
+		userPath = expandEnv(M.userJisyoPath)
+	}
+	r.wg.Add(1)
+	go r.check(targets, userPath, M.userJisyoCoding, M.userJisyoStamp)
+}
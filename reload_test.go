@@ -0,0 +1,102 @@
+package skk
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAutoReload(t *testing.T) {
+	dir := t.TempDir()
+	systemPath := filepath.Join(dir, "SKK-JISYO.test")
+	userPath := filepath.Join(dir, "user-jisyo")
+	write := func(fname, body string, stamp time.Time) {
+		t.Helper()
+		err := os.WriteFile(fname, []byte(";; -*- coding: utf-8 -*-\n"+nasiHeader+"\n"+body), 0666)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := os.Chtimes(fname, stamp, stamp); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	past := time.Now().Add(-time.Hour)
+	write(systemPath, "かんじ /漢字/\n", past)
+	write(userPath, "かんじ /感じ/\n", past)
+
+	M, err := Config{
+		UserJisyoPath:    userPath,
+		SystemJisyoPaths: []string{systemPath},
+		AutoReload:       time.Nanosecond,
+		BindTo:           dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+	M.User.storeAndLearn("へんかん", false, []candidateT{candidateStringT("変換")})
+
+	join := func(key string) string {
+		list, _ := M.lookup(key, false)
+		var s []string
+		for _, c := range list {
+			s = append(s, c.String())
+		}
+		return strings.Join(s, ",")
+	}
+	if result := join("かんじ"); result != "感じ,漢字" {
+		t.Fatalf("before reload: %s", result)
+	}
+	write(systemPath, "かんじ /漢字/幹事/\n", past.Add(time.Minute))
+	write(userPath, "かんじ /感じ/完治/\n", past.Add(time.Minute))
+
+	M.autoReload()       // start checking
+	M.reloader.wg.Wait() // wait to finish checking
+	if result := join("かんじ"); result != "感じ,漢字" {
+		t.Fatalf("must not be applied before autoReload: %s", result)
+	}
+	M.autoReload() // apply
+	if result := join("かんじ"); result != "感じ,完治,漢字,幹事" {
+		t.Fatalf("after reload: %s", result)
+	}
+	if result := join("へんかん"); result != "変換" {
+		t.Fatalf("learned words must be kept: %s", result)
+	}
+}
+
+type closeCounter struct {
+	*Jisyo
+	closed *int
+}
+
+func (c closeCounter) Close() error {
+	*c.closed++
+	return nil
+}
+
+func TestAutoReloadRemoved(t *testing.T) {
+	M, err := Config{AutoReload: time.Hour, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+	M.layers = append(M.layers, &jisyoLayer{name: "test", dictionary: newJisyo(), path: "SKK-JISYO.test"})
+	if _, err := M.RemoveSystemJisyo("test"); err != nil {
+		t.Fatal(err.Error())
+	}
+	// the layer reloaded while it was removed
+	closed := 0
+	M.reloader.layers["SKK-JISYO.test"] = &jisyoLayer{
+		dictionary: closeCounter{Jisyo: newJisyo(), closed: &closed},
+		path:       "SKK-JISYO.test",
+	}
+	M.autoReload()
+	if len(M.layers) != 0 {
+		t.Fatalf("the removed layer is installed again: %d layers", len(M.layers))
+	}
+	if closed != 1 {
+		t.Fatalf("expect the reloaded layer closed, but %d", closed)
+	}
+}
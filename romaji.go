@@ -227,6 +227,7 @@ var hankakuKatakana = &_Kana{
 type _Romaji struct {
 	kana *_Kana
 	last string
+	M    *Mode // to show the dynamic completion (can be nil)
 }
 
 func (R *_Romaji) String() string {
@@ -236,6 +237,7 @@ func (R *_Romaji) String() string {
 func (R *_Romaji) Call(ctx context.Context, B *readline.Buffer) readline.Result {
 	if value, ok := R.kana.Query(R.last); ok {
 		B.InsertAndRepaint(value)
+		R.inserted(B)
 		return readline.CONTINUE
 	}
 	var buffer strings.Builder
@@ -252,6 +254,7 @@ func (R *_Romaji) Call(ctx context.Context, B *readline.Buffer) readline.Result
 		buffer.WriteRune(c)
 		if value, ok := R.kana.Query(buffer.String()); ok {
 			B.ReplaceAndRepaint(from, value)
+			R.inserted(B)
 			if u, _ := utf8.DecodeLastRuneInString(value); u != c {
 				return readline.CONTINUE
 			}
@@ -263,3 +266,10 @@ func (R *_Romaji) Call(ctx context.Context, B *readline.Buffer) readline.Result
 		}
 	}
 }
+
+// inserted is called after the kana is inserted
+func (R *_Romaji) inserted(B *readline.Buffer) {
+	if R.M != nil {
+		R.M.showDynamicCompletion(B)
+	}
+}
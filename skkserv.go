@@ -0,0 +1,223 @@
+package skk
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+)
+
+const (
+	skkServDefaultPort    = "1178"
+	skkServDefaultTimeout = 3 * time.Second
+	// skkServRetryDelay is how long a server which did not respond
+	// is skipped not to wait for the timeout on every conversion.
+	skkServRetryDelay = 30 * time.Second
+)
+
+// skkServ is a client of the skkserv protocol (yaskkserv, dbskkd-cdb...)
+// It keeps one connection and reconnects to the next address
+// when the current server does not respond.
+type skkServ struct {
+	addrs    []string
+	timeout  time.Duration
+	encoding encoding.Encoding
+
+	mu      sync.Mutex
+	conn    net.Conn
+	br      *bufio.Reader
+	next    int
+	retryAt []time.Time // the time until when each server is skipped
+}
+
+func newSkkServ(addrs []string, timeout time.Duration, utf8mode bool) *skkServ {
+	if timeout <= 0 {
+		timeout = skkServDefaultTimeout
+	}
+	s := &skkServ{timeout: timeout}
+	for _, addr := range addrs {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addr = net.JoinHostPort(addr, skkServDefaultPort)
+		}
+		s.addrs = append(s.addrs, addr)
+	}
+	s.retryAt = make([]time.Time, len(s.addrs))
+	if !utf8mode {
+		s.encoding = japanese.EUCJP
+	}
+	return s
+}
+
+func (s *skkServ) connect() error {
+	if len(s.addrs) <= 0 {
+		return errors.New("skkserv: no server addresses")
+	}
+	var errs []error
+	for i := 0; i < len(s.addrs); i++ {
+		n := (s.next + i) % len(s.addrs)
+		if time.Now().Before(s.retryAt[n]) {
+			errs = append(errs, fmt.Errorf("%s: not responding", s.addrs[n]))
+			continue
+		}
+		conn, err := net.DialTimeout("tcp", s.addrs[n], s.timeout)
+		if err == nil {
+			s.next = n
+			s.conn = conn
+			s.br = bufio.NewReader(conn)
+			return nil
+		}
+		s.fail(n)
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// fail makes the n-th server skipped for skkServRetryDelay
+func (s *skkServ) fail(n int) {
+	s.retryAt[n] = time.Now().Add(skkServRetryDelay)
+}
+
+func (s *skkServ) disconnect() {
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+		s.br = nil
+	}
+}
+
+func (s *skkServ) encode(text string) (string, error) {
+	if s.encoding == nil {
+		return text, nil
+	}
+	return s.encoding.NewEncoder().String(text)
+}
+
+func (s *skkServ) decode(text string) (string, error) {
+	if s.encoding == nil {
+		return text, nil
+	}
+	return s.encoding.NewDecoder().String(text)
+}
+
+func (s *skkServ) try(request string, delim byte) (string, error) {
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return "", err
+		}
+	}
+	s.conn.SetDeadline(time.Now().Add(s.timeout))
+	if _, err := s.conn.Write([]byte(request)); err != nil {
+		return "", err
+	}
+	if delim == 0 {
+		return s.readChunk()
+	}
+	return s.br.ReadString(delim)
+}
+
+// readChunk returns the data the server sent at once. The replies of
+// "2" and "3" have no terminator and may contain spaces ("yaskkserv2 0.1.1 ").
+func (s *skkServ) readChunk() (string, error) {
+	if _, err := s.br.Peek(1); err != nil {
+		return "", err
+	}
+	data, err := s.br.Peek(s.br.Buffered())
+	if err != nil {
+		return "", err
+	}
+	reply := string(data)
+	s.br.Discard(len(data))
+	return reply, nil
+}
+
+// send sends the request and returns the reply until `delim`,
+// or the data sent at once when `delim` is 0.
+// When the connection is broken, it retries once with the next server.
+func (s *skkServ) send(request string, delim byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reply, err := s.try(request, delim)
+	if err != nil {
+		if s.conn != nil && errors.Is(err, os.ErrDeadlineExceeded) {
+			s.fail(s.next)
+		}
+		s.disconnect()
+		s.next++
+		if reply, err = s.try(request, delim); err != nil {
+			s.disconnect()
+			return "", fmt.Errorf("skkserv: %w", err)
+		}
+	}
+	return s.decode(reply)
+}
+
+// request sends `cmd`+`arg`+" " and returns the reply line without "\n".
+func (s *skkServ) request(cmd byte, arg string) (string, error) {
+	arg, err := s.encode(arg)
+	if err != nil {
+		return "", err
+	}
+	reply, err := s.send(fmt.Sprintf("%c%s ", cmd, arg), '\n')
+	return strings.TrimRight(reply, "\r\n"), err
+}
+
+// version returns the version of the server (request "2")
+func (s *skkServ) version() (string, error) {
+	reply, err := s.send("2", 0)
+	return strings.TrimRight(reply, " \r\n"), err
+}
+
+// host returns the hostname and the addresses of the server like
+// "hostname:127.0.0.1:" (request "3")
+func (s *skkServ) host() (string, error) {
+	reply, err := s.send("3", 0)
+	return strings.TrimRight(reply, " \r\n"), err
+}
+
+func (s *skkServ) lookup(key string, _ bool) ([]candidateT, bool) {
+	reply, err := s.request('1', key)
+	if err != nil || len(reply) < 2 || reply[0] != '1' || reply[1] != '/' {
+		return nil, false
+	}
+	list := parseCandidates(reply[2:], nil)
+	return list, len(list) > 0
+}
+
+// complete returns the readings starting with `prefix` that the server knows.
+func (s *skkServ) complete(prefix string) ([]string, error) {
+	reply, err := s.request('4', prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) < 2 || reply[0] != '1' || reply[1] != '/' {
+		return nil, nil
+	}
+	var result []string
+	for _, key := range strings.Split(reply[2:], "/") {
+		if key != "" {
+			result = append(result, key)
+		}
+	}
+	return result, nil
+}
+
+// Close sends the disconnect request "0" and closes the connection.
+func (s *skkServ) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	s.conn.SetDeadline(time.Now().Add(s.timeout))
+	_, err := s.conn.Write([]byte{'0'})
+	s.disconnect()
+	return err
+}
@@ -0,0 +1,205 @@
+package skk
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// fakeSkkServ starts a skkserv speaking EUC-JP which knows only `dic`.
+func fakeSkkServ(t *testing.T, dic map[string]string) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				r := japanese.EUCJP.NewDecoder().Reader(conn)
+				w := japanese.EUCJP.NewEncoder().Writer(conn)
+				br := bufio.NewReader(r)
+				for {
+					cmd, err := br.ReadByte()
+					if err != nil || cmd == '0' {
+						return
+					}
+					switch cmd {
+					case '2':
+						w.Write([]byte("fakeskkserv 0.1 "))
+						continue
+					case '3':
+						w.Write([]byte("fakehost:127.0.0.1: "))
+						continue
+					}
+					arg, err := br.ReadString(' ')
+					if err != nil {
+						return
+					}
+					arg = strings.TrimSuffix(arg, " ")
+					switch cmd {
+					case '1':
+						if value, ok := dic[arg]; ok {
+							w.Write([]byte("1" + value + "\n"))
+						} else {
+							w.Write([]byte("4" + arg + " \n"))
+						}
+					case '4':
+						var keys []string
+						for key := range dic {
+							if strings.HasPrefix(key, arg) {
+								keys = append(keys, key)
+							}
+						}
+						if len(keys) > 0 {
+							w.Write([]byte("1/" + strings.Join(keys, "/") + "/\n"))
+						} else {
+							w.Write([]byte("4" + arg + " \n"))
+						}
+					}
+				}
+			}(conn)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// deadAddr returns an address nobody listens on.
+func deadAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return addr
+}
+
+func TestSkkServLookup(t *testing.T) {
+	addr := fakeSkkServ(t, map[string]string{
+		"かんじ": "/漢字/幹事/",
+		"おくr": "/送/贈/",
+	})
+	s := newSkkServ([]string{deadAddr(t), addr}, time.Second, false)
+	defer s.Close()
+
+	list, ok := s.lookup("かんじ", false)
+	if !ok {
+		t.Fatal("かんじ: not found")
+	}
+	if len(list) != 2 || list[0].String() != "漢字" || list[1].String() != "幹事" {
+		t.Fatalf("かんじ: unexpected candidates: %v", list)
+	}
+	if list, ok = s.lookup("おくr", true); !ok || list[1].String() != "贈" {
+		t.Fatalf("おくr: unexpected candidates: %v", list)
+	}
+	if _, ok = s.lookup("ほげ", false); ok {
+		t.Fatal("ほげ: found unexpectedly")
+	}
+	keys, err := s.complete("かん")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(keys) != 1 || keys[0] != "かんじ" {
+		t.Fatalf("complete: unexpected keys: %v", keys)
+	}
+}
+
+func TestSkkServVersionAndHost(t *testing.T) {
+	s := newSkkServ([]string{fakeSkkServ(t, nil)}, time.Second, false)
+	defer s.Close()
+
+	if version, err := s.version(); err != nil || version != "fakeskkserv 0.1" {
+		t.Fatalf("version: %s (%v)", version, err)
+	}
+	if host, err := s.host(); err != nil || host != "fakehost:127.0.0.1:" {
+		t.Fatalf("host: %s (%v)", host, err)
+	}
+	// the connection is still usable after them
+	if _, ok := s.lookup("かんじ", false); ok {
+		t.Fatal("かんじ: found unexpectedly")
+	}
+}
+
+// silentAddr returns an address which accepts connections but never replies.
+func silentAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		var conns []net.Conn
+		defer func() {
+			for _, conn := range conns {
+				conn.Close()
+			}
+		}()
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func TestSkkServSkipNotResponding(t *testing.T) {
+	const timeout = 200 * time.Millisecond
+	s := newSkkServ([]string{silentAddr(t)}, timeout, false)
+	defer s.Close()
+
+	start := time.Now()
+	if _, ok := s.lookup("かんじ", false); ok {
+		t.Fatal("found without replies")
+	}
+	if elapsed := time.Since(start); elapsed < timeout {
+		t.Fatalf("the first lookup must wait for the timeout: %v", elapsed)
+	}
+	start = time.Now()
+	if _, ok := s.lookup("かんじ", false); ok {
+		t.Fatal("found without replies")
+	}
+	if elapsed := time.Since(start); elapsed >= timeout {
+		t.Fatalf("the server not responding must be skipped: %v", elapsed)
+	}
+}
+
+func TestSkkServNoServer(t *testing.T) {
+	s := newSkkServ([]string{deadAddr(t)}, time.Second, false)
+	if _, ok := s.lookup("かんじ", false); ok {
+		t.Fatal("found without servers")
+	}
+}
+
+func TestSkkServInMode(t *testing.T) {
+	addr := fakeSkkServ(t, map[string]string{"かんじ": "/漢字/"})
+	M, err := Config{
+		SkkServAddrs: []string{addr},
+		BindTo:       dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer M.Close()
+
+	M.System.store("かんじ", false, []candidateT{candidateStringT("感じ")})
+	list, ok := M.lookup("かんじ", false)
+	if !ok || list[0].String() != "漢字" {
+		t.Fatalf("skkserv should be consulted before the system dictionary: %v", list)
+	}
+}
@@ -0,0 +1,194 @@
+package skk
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// JisyoServer serves a Jisyo with the skkserv protocol.
+//
+//	"0"          : disconnect
+//	"1<reading> ": lookup (reply "1/c1/c2/.../\n" or "4<reading> \n")
+//	"2"          : version
+//	"3"          : hostname and address
+//	"4<prefix> " : completion (reply "1/key1/key2/.../\n" or "4<prefix> \n")
+type JisyoServer struct {
+	Jisyo *Jisyo
+	// Version is the reply for "2". When empty, "go-readline-skk " is used.
+	Version string
+	// UTF8 is true to speak UTF-8 instead of EUC-JP
+	UTF8 bool
+	// MaxCompletion is the maximum number of keys replied for "4" (default: 100)
+	MaxCompletion int
+}
+
+func (s *JisyoServer) encoding() encoding.Encoding {
+	if s.UTF8 {
+		return encoding.Nop
+	}
+	return japanese.EUCJP
+}
+
+// Serve accepts connections on ln and serves each one on a goroutine.
+func (s *JisyoServer) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			s.ServeConn(conn, conn.LocalAddr())
+		}()
+	}
+}
+
+// isOkuriAriKey reports whether the key is a reading with okurigana like "おくr"
+func isOkuriAriKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(key)
+	last := key[len(key)-1]
+	return first >= utf8.RuneSelf && 'a' <= last && last <= 'z'
+}
+
+func (s *JisyoServer) lookup(key string) ([]candidateT, bool) {
+	if key == "" {
+		return nil, false
+	}
+	if isOkuriAriKey(key) {
+		if list, ok := s.Jisyo.lookup(key, true); ok {
+			return list, true
+		}
+	}
+	return s.Jisyo.lookup(key, false)
+}
+
+func (s *JisyoServer) complete(prefix string) []string {
+	limit := s.MaxCompletion
+	if limit <= 0 {
+		limit = 100
+	}
+	var keys []string
+	if prefix == "" {
+		return keys
+	}
+	for key := range s.Jisyo.nasi {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return keys
+}
+
+// replyCandidates makes the reply for "1".
+// Candidates which can not be represented with the encoding are dropped.
+func (s *JisyoServer) replyCandidates(list []candidateT) (string, bool) {
+	enc := s.encoding().NewEncoder()
+	if !s.UTF8 {
+		newList := make([]candidateT, 0, len(list))
+		for _, c := range list {
+			if _, err := enc.String(c.Source()); err == nil {
+				newList = append(newList, c)
+			}
+		}
+		list = newList
+	}
+	if len(list) <= 0 {
+		return "", false
+	}
+	var buffer strings.Builder
+	buffer.WriteByte('1')
+	dumpCandidates(list, &buffer)
+	buffer.WriteByte('\n')
+	return buffer.String(), true
+}
+
+func (s *JisyoServer) reply(cmd byte, arg string, addr net.Addr) string {
+	switch cmd {
+	case '1':
+		if list, ok := s.lookup(arg); ok {
+			if reply, ok := s.replyCandidates(list); ok {
+				return reply
+			}
+		}
+	case '2':
+		if s.Version != "" {
+			return s.Version
+		}
+		return "go-readline-skk "
+	case '3':
+		hostname, _ := os.Hostname()
+		if addr != nil {
+			return fmt.Sprintf("%s:%s: ", hostname, addr.String())
+		}
+		return hostname + ": "
+	case '4':
+		if keys := s.complete(arg); len(keys) > 0 {
+			return "1/" + strings.Join(keys, "/") + "/\n"
+		}
+	}
+	return "4" + arg + " \n"
+}
+
+// ServeConn serves the requests from one client until it sends "0" or disconnects.
+// addr is used for the reply of "3".
+func (s *JisyoServer) ServeConn(conn io.ReadWriter, addr net.Addr) error {
+	br := bufio.NewReader(conn)
+	enc := s.encoding()
+	for {
+		cmd, err := br.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		var arg string
+		switch cmd {
+		case '0':
+			return nil
+		case '1', '4':
+			arg, err = br.ReadString(' ')
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			arg = strings.TrimRight(arg, " \r\n")
+			if arg, err = enc.NewDecoder().String(arg); err != nil {
+				arg = ""
+			}
+		case '2', '3':
+		default:
+			// blank or newline sent after a request by some clients
+			continue
+		}
+		reply, err := enc.NewEncoder().String(s.reply(cmd, arg, addr))
+		if err != nil {
+			reply = "4 \n"
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package skk
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJisyoServer(t *testing.T) {
+	jisyo := newJisyo()
+	err := jisyo.Read(strings.NewReader(
+		";; -*- coding: utf-8 -*-\n" +
+			ariHeader + "\n" +
+			"おくr /送/\n" +
+			nasiHeader + "\n" +
+			"かんじ /漢字/幹事/\n" +
+			"かんこく /韓国/\n" +
+			"すらっしゅ /(concat \"\\057\")/\n" +
+			"えもじ /😀/\n"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer ln.Close()
+	go (&JisyoServer{Jisyo: jisyo}).Serve(ln)
+
+	client := newSkkServ([]string{ln.Addr().String()}, time.Second, false)
+	defer client.Close()
+
+	test := func(key, expect string) {
+		t.Helper()
+		list, ok := client.lookup(key, false)
+		var result []string
+		for _, c := range list {
+			result = append(result, c.String())
+		}
+		if actual := strings.Join(result, ","); !ok && expect != "" || actual != expect {
+			t.Fatalf("%s: expect %q, but %q", key, expect, actual)
+		}
+	}
+	test("かんじ", "漢字,幹事")
+	test("おくr", "送")
+	test("すらっしゅ", "/")
+	test("えもじ", "") // EUC-JP can not represent it
+	test("ほげ", "")
+
+	keys, err := client.complete("かん")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if actual := strings.Join(keys, ","); actual != "かんこく,かんじ" {
+		t.Fatalf("complete: %q", actual)
+	}
+}
@@ -0,0 +1,137 @@
+package skk
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"golang.org/x/text/encoding"
+)
+
+// sortedJisyo is a read-only dictionary which keeps the image of the file
+// and the offsets of lines sorted by the key. It parses the candidates
+// only for the key actually looked up. Building the index still scans
+// the whole image once at loading (O(n) and a few bytes for each line,
+// plus O(n log n) when the lines are not sorted yet), but allocates
+// no candidates.
+type sortedJisyo struct {
+	data     []byte
+	encoding encoding.Encoding // nil for UTF-8
+	ari      []int
+	nasi     []int
+}
+
+// loadSortedJisyo loads the dictionary file with the encoding named `coding`
+// (detected when it is empty).
+func loadSortedJisyo(filename, coding string) (*sortedJisyo, error) {
+	fd, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	r, err := decompress(fd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return newSortedJisyo(data, coding), nil
+}
+
+func newSortedJisyo(data []byte, coding string) *sortedJisyo {
+	head := data
+	if len(head) > headSize {
+		head = head[:headSize]
+	}
+	enc, bom := jisyoEncoding(head, coding)
+	data = data[bom:]
+	if !searchableOnImage(enc) {
+		if utf8, err := enc.NewDecoder().Bytes(data); err == nil {
+			data = utf8
+			enc = nil
+		}
+	}
+	s := &sortedJisyo{data: data, encoding: enc}
+
+	okuri := false
+	for pos := 0; pos < len(data); {
+		line := data[pos:]
+		next := len(data)
+		if i := bytes.IndexByte(line, '\n'); i >= 0 {
+			line = line[:i]
+			next = pos + i + 1
+		}
+		if len(line) > 0 && line[0] == ';' {
+			if bytes.HasPrefix(line, []byte(ariHeader)) {
+				okuri = true
+			} else if bytes.HasPrefix(line, []byte(nasiHeader)) {
+				okuri = false
+			}
+		} else if bytes.Contains(line, []byte(" /")) {
+			if okuri {
+				s.ari = append(s.ari, pos)
+			} else {
+				s.nasi = append(s.nasi, pos)
+			}
+		}
+		pos = next
+	}
+	// Both indices are sorted in ascending order for the binary search.
+	// SKK-JISYO.* sort okuri-ari entries in descending order,
+	// so s.ari is usually re-sorted here.
+	s.sort(s.ari)
+	s.sort(s.nasi)
+	return s
+}
+
+func (s *sortedJisyo) keyAt(pos int) []byte {
+	line := s.data[pos:]
+	key, _, _ := bytes.Cut(line, []byte(" /"))
+	return key
+}
+
+func (s *sortedJisyo) sort(index []int) {
+	less := func(i, j int) bool {
+		return bytes.Compare(s.keyAt(index[i]), s.keyAt(index[j])) < 0
+	}
+	if !sort.SliceIsSorted(index, less) {
+		sort.SliceStable(index, less)
+	}
+}
+
+func (s *sortedJisyo) lookup(key string, okuri bool) ([]candidateT, bool) {
+	index := s.nasi
+	if okuri {
+		index = s.ari
+	}
+	rawKey := []byte(key)
+	if s.encoding != nil {
+		var err error
+		if rawKey, err = s.encoding.NewEncoder().Bytes(rawKey); err != nil {
+			return nil, false
+		}
+	}
+	var values []candidateT
+	i := sort.Search(len(index), func(i int) bool {
+		return bytes.Compare(s.keyAt(index[i]), rawKey) >= 0
+	})
+	for ; i < len(index) && bytes.Equal(s.keyAt(index[i]), rawKey); i++ {
+		line := s.data[index[i]+len(rawKey)+len(" /"):]
+		if end := bytes.IndexByte(line, '\n'); end >= 0 {
+			line = line[:end]
+		}
+		line = bytes.TrimRight(line, "\r")
+		if s.encoding != nil {
+			var err error
+			if line, err = s.encoding.NewDecoder().Bytes(line); err != nil {
+				continue
+			}
+		}
+		values = parseCandidates(string(line), values)
+	}
+	return values, len(values) > 0
+}
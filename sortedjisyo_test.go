@@ -0,0 +1,66 @@
+package skk
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+const sampleJisyo = ariHeader + "\n" +
+	"わすr /忘/\n" +
+	"おくr /送/贈/\n" +
+	"あるk /歩/\n" +
+	nasiHeader + "\n" +
+	"#じ /#1時/#0時/\n" +
+	"かんじ /漢字/幹事/\n" +
+	"かんこく /韓国/\n" +
+	"かんじ /感じ/\n" +
+	"すらっしゅ /(concat \"\\057\")/\n"
+
+func TestSortedJisyo(t *testing.T) {
+	eucjp, err := japanese.EUCJP.NewEncoder().String(sampleJisyo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	lazy := newSortedJisyo([]byte(eucjp), "")
+	full := newJisyo()
+	if err := full.Read(strings.NewReader(eucjp)); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, key := range []string{"わすr", "おくr", "あるk", "#じ", "かんじ", "かんこく", "すらっしゅ", "ほげ", "かん"} {
+		okuri := isOkuriAriKey(key)
+		expect, expectOk := full.lookup(key, okuri)
+		result, resultOk := lazy.lookup(key, okuri)
+		if expectOk != resultOk || len(expect) != len(result) {
+			t.Fatalf("%s: expect %v, but %v", key, expect, result)
+		}
+		for i := range expect {
+			if expect[i].String() != result[i].String() {
+				t.Fatalf("%s: expect %v, but %v", key, expect, result)
+			}
+		}
+	}
+}
+
+func TestLazySystemJisyo(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "SKK-JISYO.test")
+	err := os.WriteFile(fname, []byte(";; -*- coding: utf-8 -*-\n"+sampleJisyo), 0666)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M, err := Config{
+		SystemJisyoPaths: []string{fname},
+		LazySystemJisyo:  true,
+		BindTo:           dummyKeyMap{},
+	}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	list, ok := M.lookup("12じ", false)
+	if !ok || len(list) != 2 || list[0].String() != "１２時" || list[1].String() != "12時" {
+		t.Fatalf("12じ: %v", list)
+	}
+}
@@ -0,0 +1,264 @@
+package skk
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nyaosorg/go-readline-ny"
+)
+
+const (
+	// studyMaxCandidates is the number of candidates remembered per context
+	studyMaxCandidates = 8
+	// studyMaxEntries is the number of contexts saved (the recent ones are kept)
+	studyMaxEntries = 5000
+)
+
+// studyKey is the context: the reading converted after the previous word.
+type studyKey struct {
+	prev  string
+	key   string
+	okuri bool
+}
+
+type studyEntry struct {
+	sources []string // the sources of candidates chosen (the recent first)
+	seq     int      // larger is more recent
+}
+
+// study remembers which candidate was chosen for a reading after
+// the word previously converted in the same line like skk-study of ddskk.
+type study struct {
+	entries map[studyKey]*studyEntry
+	changed map[studyKey]bool // changed since loaded
+	seq     int
+}
+
+func newStudy() *study {
+	return &study{
+		entries: map[studyKey]*studyEntry{},
+		changed: map[studyKey]bool{},
+	}
+}
+
+// studyPath is the file of the study data next to the user dictionary
+func studyPath(userJisyoPath string) string {
+	return userJisyoPath + ".study"
+}
+
+// record remembers that `c` was chosen for `key` after `prev`
+func (s *study) record(prev, key string, okuri bool, c candidateT) {
+	k := studyKey{prev: prev, key: key, okuri: okuri}
+	e, ok := s.entries[k]
+	if !ok {
+		e = &studyEntry{}
+		s.entries[k] = e
+	}
+	source := c.Source()
+	sources := []string{source}
+	for _, s1 := range e.sources {
+		if s1 != source && len(sources) < studyMaxCandidates {
+			sources = append(sources, s1)
+		}
+	}
+	e.sources = sources
+	s.seq++
+	e.seq = s.seq
+	s.changed[k] = true
+}
+
+// reorder moves the candidates chosen for `key` after `prev` to the top
+// in the order they were chosen recently.
+func (s *study) reorder(prev, key string, okuri bool, list []candidateT) []candidateT {
+	e, ok := s.entries[studyKey{prev: prev, key: key, okuri: okuri}]
+	if !ok {
+		return list
+	}
+	// the candidates of "#4" share the source, so that they are
+	// compared by the positions instead of containsCandidate
+	result := make([]candidateT, 0, len(list))
+	used := make([]bool, len(list))
+	for _, source := range e.sources {
+		for i, c := range list {
+			if !used[i] && c.Source() == source {
+				result = append(result, c)
+				used[i] = true
+			}
+		}
+	}
+	for i, c := range list {
+		if !used[i] {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// read reads the study data written by writeTo:
+// the lines of "reading<TAB>previous word<TAB>/c1/c2/.../" in the sections
+// of okuri-ari and okuri-nasi from the most recent.
+func (s *study) read(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	okuri := false
+	var lines []studyKey
+	var sources [][]string
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, ";;") {
+			if strings.HasPrefix(line, ariHeader) {
+				okuri = true
+			} else if strings.HasPrefix(line, nasiHeader) {
+				okuri = false
+			}
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) < 3 {
+			continue
+		}
+		var list []string
+		for _, c := range parseCandidates(strings.TrimPrefix(fields[2], "/"), nil) {
+			list = append(list, c.Source())
+		}
+		if len(list) <= 0 {
+			continue
+		}
+		lines = append(lines, studyKey{key: fields[0], prev: fields[1], okuri: okuri})
+		sources = append(sources, list)
+	}
+	// the lines are from the most recent
+	for i := len(lines) - 1; i >= 0; i-- {
+		s.seq++
+		s.entries[lines[i]] = &studyEntry{sources: sources[i], seq: s.seq}
+	}
+	return sc.Err()
+}
+
+func (s *study) load(filename string) error {
+	fd, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	return s.read(fd)
+}
+
+// writeTo writes the most recent studyMaxEntries contexts
+func (s *study) writeTo(w io.Writer) (n int64, err error) {
+	keys := make([]studyKey, 0, len(s.entries))
+	for k := range s.entries {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return s.entries[keys[i]].seq > s.entries[keys[j]].seq
+	})
+	if len(keys) > studyMaxEntries {
+		keys = keys[:studyMaxEntries]
+	}
+	var wc writeCounter
+	if wc.Try(fmt.Fprintln(w, ";; -*- mode: fundamental; coding: utf-8 -*-")) {
+		return wc.Result()
+	}
+	for _, okuri := range []bool{true, false} {
+		header := nasiHeader
+		if okuri {
+			header = ariHeader
+		}
+		if wc.Try(fmt.Fprintln(w, header)) {
+			return wc.Result()
+		}
+		for _, k := range keys {
+			if k.okuri != okuri {
+				continue
+			}
+			if wc.Try(fmt.Fprintf(w, "%s\t%s\t/", k.key, k.prev)) {
+				return wc.Result()
+			}
+			for _, source := range s.entries[k].sources {
+				if wc.Try(fmt.Fprintf(w, "%s/", source)) {
+					return wc.Result()
+				}
+			}
+			if wc.Try(fmt.Fprintln(w)) {
+				return wc.Result()
+			}
+		}
+	}
+	return wc.Result()
+}
+
+// save writes the study data to filename merging the contexts
+// studied by other processes which this process did not change.
+func (s *study) save(filename string) error {
+	other := newStudy()
+	if err := other.load(filename); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for k, e := range other.entries {
+		if _, ok := s.entries[k]; !ok {
+			s.entries[k] = e
+		} else if !s.changed[k] {
+			s.entries[k].sources = e.sources
+		}
+	}
+	tmpName := filename + ".TMP"
+	fd, err := os.Create(tmpName)
+	if err != nil {
+		return err
+	}
+	if _, err := s.writeTo(fd); err != nil {
+		fd.Close()
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, filename); err != nil {
+		return err
+	}
+	s.changed = map[studyKey]bool{}
+	return nil
+}
+
+// studyContext is the word confirmed last, the line and the position
+// where it was put. Since the line editor allocates a new buffer for
+// every line, the context is not carried over to the next line even
+// when another command than ours accepts the line.
+type studyContext struct {
+	buffer *readline.Buffer
+	pos    int
+	word   string
+}
+
+// previousWord returns the word confirmed last when it is still
+// at its position of the same line before the marker.
+func (M *Mode) previousWord(B *readline.Buffer, markerPos int) string {
+	w := M.lastWord
+	if w.word == "" || w.buffer != B || w.pos > markerPos ||
+		!strings.HasPrefix(B.SubString(w.pos, markerPos), w.word) {
+		return ""
+	}
+	return w.word
+}
+
+// studyResult remembers the candidate `c` chosen for `source` with
+// the previous word, and records it as the previous word of the next.
+// `markerPos` is the position where the word is put.
+func (M *Mode) studyResult(B *readline.Buffer, markerPos int, source string, okuri bool, c candidateT) {
+	if M.study == nil {
+		return
+	}
+	if isCalcResult(c) {
+		M.lastWord = studyContext{}
+		return
+	}
+	if prev := M.previousWord(B, markerPos); prev != "" {
+		M.study.record(prev, source, okuri, c)
+	}
+	word, _ := splitAnnotation(c.String())
+	M.lastWord = studyContext{buffer: B, pos: markerPos, word: word}
+}
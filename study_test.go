@@ -0,0 +1,141 @@
+package skk
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nyaosorg/go-readline-ny"
+	"github.com/nyaosorg/go-ttyadapter/auto"
+)
+
+func TestStudy(t *testing.T) {
+	userPath := filepath.Join(t.TempDir(), "user-jisyo")
+	M, err := Config{UserJisyoPath: userPath, Study: true, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	list := []candidateT{candidateStringT("機会"), candidateStringT("機械"), candidateStringT("奇怪")}
+	M.study.record("工作", "きかい", false, list[1])
+	M.study.record("送", "おくr", true, candidateStringT("贈"))
+
+	if result := dumpString(M.study.reorder("工作", "きかい", false, list)); result != "/機械/機会/奇怪/" {
+		t.Fatalf("expect /機械/機会/奇怪/, but %s", result)
+	}
+	if result := dumpString(M.study.reorder("絶好", "きかい", false, list)); result != "/機会/機械/奇怪/" {
+		t.Fatalf("expect /機会/機械/奇怪/ for another context, but %s", result)
+	}
+	if err := M.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := os.Stat(studyPath(userPath)); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	M2, err := Config{UserJisyoPath: userPath, Study: true, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if result := dumpString(M2.study.reorder("工作", "きかい", false, list)); result != "/機械/機会/奇怪/" {
+		t.Fatalf("expect /機械/機会/奇怪/ after loading, but %s", result)
+	}
+	okuriList := []candidateT{candidateStringT("送"), candidateStringT("贈")}
+	if result := dumpString(M2.study.reorder("送", "おくr", true, okuriList)); result != "/贈/送/" {
+		t.Fatalf("expect /贈/送/ after loading, but %s", result)
+	}
+
+	// the contexts studied by the other process are kept
+	M2.study.record("精密", "きかい", false, list[1])
+	M.study.record("工作", "きかい", false, list[2])
+	if err := M2.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := M.SaveUserJisyo(); err != nil {
+		t.Fatal(err.Error())
+	}
+	s := newStudy()
+	if err := s.load(studyPath(userPath)); err != nil {
+		t.Fatal(err.Error())
+	}
+	if result := dumpString(s.reorder("精密", "きかい", false, list)); result != "/機械/機会/奇怪/" {
+		t.Fatalf("expect /機械/機会/奇怪/ studied by the other, but %s", result)
+	}
+	if result := dumpString(s.reorder("工作", "きかい", false, list)); result != "/奇怪/機械/機会/" {
+		t.Fatalf("expect /奇怪/機械/機会/, but %s", result)
+	}
+}
+
+func TestStudyContext(t *testing.T) {
+	M, err := Config{Study: true, BindTo: dummyKeyMap{}}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	B := &readline.Buffer{}
+	B.InsertString(0, "工作")
+	M.studyResult(B, 0, "こうさく", false, candidateStringT("工作"))
+	if prev := M.previousWord(B, 2); prev != "工作" {
+		t.Fatalf("expect 工作, but %q", prev)
+	}
+
+	// a substring of another word is not the previous word
+	B2 := &readline.Buffer{}
+	B2.InsertString(0, "工作")
+	M.studyResult(B2, 0, "こう", false, candidateStringT("工"))
+	B2.InsertString(0, "人")
+	if prev := M.previousWord(B2, 3); prev != "" {
+		t.Fatalf("expect no previous word for 人工作, but %q", prev)
+	}
+
+	// the next line has no previous word even with the same text
+	B3 := &readline.Buffer{}
+	B3.InsertString(0, "工作")
+	if prev := M.previousWord(B3, 2); prev != "" {
+		t.Fatalf("expect no previous word on the next line, but %q", prev)
+	}
+
+	// the annotation is studied with the word but not in the context
+	M.studyResult(B3, 0, "こうさく", false, candidateStringT("工作"))
+	B3.InsertString(2, "機械")
+	M.studyResult(B3, 2, "きかい", false, candidateStringT("機械;注釈"))
+	list := []candidateT{candidateStringT("機会"), candidateStringT("機械;注釈")}
+	if result := dumpString(M.study.reorder("工作", "きかい", false, list)); result != "/機械;注釈/機会/" {
+		t.Fatalf("expect /機械;注釈/機会/, but %s", result)
+	}
+	if prev := M.previousWord(B3, 4); prev != "機械" {
+		t.Fatalf("expect 機械 without the annotation, but %q", prev)
+	}
+}
+
+func TestStudyFromCandidateList(t *testing.T) {
+	var output strings.Builder
+	editor := &readline.Editor{
+		Writer:       &output,
+		PromptWriter: func(w io.Writer) (int, error) { return 0, nil },
+	}
+	M, err := Config{Study: true, BindTo: &editor.KeyMap}.Setup()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	M.System.store("こうさく", false, parseCandidates("/工作/", nil))
+	list := parseCandidates("/機会/機械/奇怪/棋界/器械/貴下い/", nil)
+	M.System.store("きかい", false, list)
+
+	// 工作 and the 5th candidate of きかい chosen with "a" on the list
+	editor.Tty = &auto.Pilot{Text: []string{
+		"\n", "K", "o", "u", "s", "a", "k", "u", " ",
+		"K", "i", "k", "a", "i", " ", " ", " ", " ", " ", "a", "\r",
+	}}
+	result, err := editor.ReadLine(context.Background())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if result != "工作器械" {
+		t.Fatalf("expect 工作器械, but %q", result)
+	}
+	if r := dumpString(M.study.reorder("工作", "きかい", false, list)); r != "/器械/機会/機械/奇怪/棋界/貴下い/" {
+		t.Fatalf("expect 器械 studied for きかい, but %s", r)
+	}
+}